@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -27,8 +28,52 @@ var (
 	// authorized or the user does not have access to the
 	// resource.
 	ErrNotAuthorized = errors.New("Not Authorized")
+
+	// ErrDiffTooLarge indicates a raw diff exceeds the
+	// maximum size that is read into memory.
+	ErrDiffTooLarge = errors.New("Diff Too Large")
+
+	// ErrResponseTooLarge indicates a response body exceeds
+	// the maximum size that is read by the client.
+	ErrResponseTooLarge = errors.New("Response Too Large")
+
+	// ErrRateLimit indicates the request was rejected by a
+	// provider rate limit. The returned error is usually a
+	// *RateLimitError that wraps ErrRateLimit.
+	ErrRateLimit = errors.New("Rate Limit Exceeded")
+
+	// ErrEmptyRepository indicates the repository has no
+	// commits, and therefore no default branch or content.
+	ErrEmptyRepository = errors.New("Repository Is Empty")
 )
 
+// RateLimitError is returned when the provider rejects the
+// request with a secondary rate limit. RetryAfter is the time
+// the provider asked the client to wait before retrying, or
+// zero if the provider did not specify it.
+type RateLimitError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Message == "" {
+		return ErrRateLimit.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns ErrRateLimit so that the error can be
+// matched with errors.Is.
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimit
+}
+
+// DefaultMaxResponseBytes is the maximum size of a response
+// body that is read when the client MaxResponseBytes option
+// is not set.
+const DefaultMaxResponseBytes int64 = 100 << 20
+
 type (
 	// Request represents an HTTP request.
 	Request struct {
@@ -36,6 +81,11 @@ type (
 		Path   string
 		Header http.Header
 		Body   io.Reader
+
+		// Stream indicates the response body is returned to
+		// the caller unread, such as an archive download, and
+		// is not limited by the client MaxResponseBytes.
+		Stream bool
 	}
 
 	// Response represents an HTTP response.
@@ -47,6 +97,23 @@ type (
 
 		Page Page // Page values
 		Rate Rate // Rate limit snapshot
+
+		// Truncated reports whether the provider truncated
+		// the results, such as a large repository tree.
+		Truncated bool
+
+		// Redirected reports whether the request was
+		// redirected, as is the case when a repository has
+		// been renamed or transferred. URL is the location
+		// of the final request.
+		Redirected bool
+		URL        string
+
+		// Retries is the number of times the request was
+		// retried by a retrying transport, such as the
+		// transport.Retry transport, before this response
+		// was received.
+		Retries int
 	}
 
 	// Page represents parsed link rel values for
@@ -67,11 +134,42 @@ type (
 		Reset     int64
 	}
 
+	// Capabilities describes the optional features that
+	// are supported by a driver. Calling an unsupported
+	// feature returns ErrNotSupported.
+	Capabilities struct {
+		Issues              bool // issue tracker
+		IssueLabels         bool // adding and removing issue labels
+		IssueLocking        bool // locking issue discussions
+		IssueTransfer       bool // transferring issues between repositories
+		LinkedIssues        bool // issues closed by a pull request
+		Rebase              bool // rebasing pull requests
+		Reviews             bool // pull request reviews
+		ReviewThreads       bool // resolving review threads
+		Statuses            bool // commit statuses
+		Collaborators       bool // adding and removing collaborators
+		Contributors        bool // listing repository contributors
+		Invitations         bool // repository invitations
+		Teams               bool // organization teams
+		Gists               bool // gists and snippets
+		Stars               bool // starring repositories
+		Archives            bool // repository archive downloads
+		RepositoryTemplates bool // creating repositories from templates
+		Pipelines           bool // triggering pipelines
+		Workflows           bool // dispatching workflows and events
+		Secrets             bool // repository secrets
+	}
+
 	// ListOptions specifies optional pagination
 	// parameters.
 	ListOptions struct {
 		URL  string
 		Page int
+
+		// Size is the page size. The GitHub, GitLab and
+		// Bitbucket Cloud drivers clamp it to the provider
+		// maximum of 100, which the provider would otherwise
+		// apply silently. Use ListAll to list every page.
 		Size int
 	}
 
@@ -89,12 +187,17 @@ type (
 		// Services used for communicating with the API.
 		Driver        Driver
 		Contents      ContentService
+		Gists         GistService
 		Git           GitService
 		Organizations OrganizationService
 		Issues        IssueService
+		Milestones    MilestoneService
+		Misc          MiscService
 		PullRequests  PullRequestService
 		Repositories  RepositoryService
 		Reviews       ReviewService
+		Rulesets      RulesetService
+		Search        SearchService
 		Users         UserService
 		Webhooks      WebhookService
 
@@ -103,9 +206,51 @@ type (
 		// This can be set to httputil.DumpResponse.
 		DumpResponse func(*http.Response, bool) ([]byte, error)
 
+		// KeepRaw optionally instructs the drivers to keep
+		// the raw JSON payload of repositories, issues and
+		// pull requests in their Raw field, so that callers
+		// can decode provider-specific fields. This is only
+		// supported by the GitHub driver.
+		KeepRaw bool
+
+		// MaxResponseBytes optionally limits the size of the
+		// response bodies decoded by the client. Streamed
+		// requests, such as archive downloads, are not
+		// limited. Reading past the limit returns
+		// ErrResponseTooLarge. If zero, the
+		// DefaultMaxResponseBytes limit is used. If negative,
+		// the response body size is not limited.
+		MaxResponseBytes int64
+
+		// WebhookMaxAge optionally rejects webhook deliveries
+		// older than the duration with ErrWebhookExpired, to
+		// protect against replayed deliveries. It is only
+		// enforced by the Bitbucket Server driver, as the other
+		// providers do not report a delivery timestamp that is
+		// covered by the signature. If zero, the age of the
+		// delivery is not checked.
+		WebhookMaxAge time.Duration
+
 		// snapshot of the request rate limit.
 		rate Rate
+
+		// features supported by the driver.
+		capabilities Capabilities
+
+		// driver request function used by DoJSON.
+		doFunc DoFunc
+
+		// driver rate limit function used by RateLimit.
+		rateLimitFunc RateLimitFunc
 	}
+
+	// DoFunc sends a provider API request, encoding the input
+	// and decoding the output as JSON.
+	DoFunc func(ctx context.Context, method, path string, in, out interface{}) (*Response, error)
+
+	// RateLimitFunc queries the provider for the current
+	// request rate limit.
+	RateLimitFunc func(ctx context.Context) (*Rate, *Response, error)
 )
 
 // Rate returns a snapshot of the request rate limit for
@@ -124,6 +269,57 @@ func (c *Client) SetRate(rate Rate) {
 	c.mu.Unlock()
 }
 
+// Capabilities returns the optional features supported by
+// the driver, so that callers can check for support before
+// calling an endpoint.
+func (c *Client) Capabilities() Capabilities {
+	return c.capabilities
+}
+
+// SetCapabilities sets the optional features supported by
+// the driver. This is called by the driver constructor.
+func (c *Client) SetCapabilities(capabilities Capabilities) {
+	c.capabilities = capabilities
+}
+
+// DoJSON sends an API request for an endpoint that is not
+// modelled by the services, reusing the client authentication,
+// error handling, rate limit and pagination parsing. The path
+// is relative to the provider API base url, for example
+// "repos/octocat/hello-world/topics" on GitHub or
+// "api/v4/projects/1/badges" on GitLab. The input is encoded
+// and the output decoded as JSON; either may be nil.
+func (c *Client) DoJSON(ctx context.Context, method, path string, in, out interface{}) (*Response, error) {
+	if c.doFunc == nil {
+		return nil, ErrNotSupported
+	}
+	return c.doFunc(ctx, method, path, in, out)
+}
+
+// SetDoFunc sets the driver request function used by DoJSON.
+// This is called by the driver constructor.
+func (c *Client) SetDoFunc(fn DoFunc) {
+	c.doFunc = fn
+}
+
+// RateLimit queries the provider for the current request
+// rate limit, without waiting for the next API call to report
+// it. The rate limit snapshot returned by Rate is updated as
+// well. It returns ErrNotSupported if the provider does not
+// report a rate limit.
+func (c *Client) RateLimit(ctx context.Context) (*Rate, *Response, error) {
+	if c.rateLimitFunc == nil {
+		return nil, nil, ErrNotSupported
+	}
+	return c.rateLimitFunc(ctx)
+}
+
+// SetRateLimitFunc sets the driver rate limit function used
+// by RateLimit. This is called by the driver constructor.
+func (c *Client) SetRateLimitFunc(fn RateLimitFunc) {
+	c.rateLimitFunc = fn
+}
+
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the
 // value pointed to by v, or returned as an error if an
@@ -147,7 +343,9 @@ func (c *Client) Do(ctx context.Context, in *Request) (*Response, error) {
 		req.URL.Opaque = strings.Split(req.URL.RawPath, "?")[0]
 	}
 
-	req = req.WithContext(ctx)
+	// counts the attempts retried by the transport.
+	retries := new(int)
+	req = req.WithContext(context.WithValue(ctx, retriesKey{}, retries))
 	if in.Header != nil {
 		req.Header = in.Header
 	}
@@ -166,7 +364,61 @@ func (c *Client) Do(ctx context.Context, in *Request) (*Response, error) {
 	if c.DumpResponse != nil {
 		c.DumpResponse(res, true)
 	}
-	return newResponse(res), nil
+
+	// limits the response body to prevent a misbehaving
+	// server from exhausting memory.
+	max := c.MaxResponseBytes
+	if max == 0 {
+		max = DefaultMaxResponseBytes
+	}
+	if max > 0 && !in.Stream {
+		res.Body = newLimitedBody(res.Body, max)
+	}
+	out := newResponse(res)
+	if res.Request != nil && res.Request.URL != nil {
+		out.URL = res.Request.URL.String()
+		out.Redirected = out.URL != req.URL.String()
+	}
+	out.Retries = *retries
+	return out, nil
+}
+
+// retriesKey is the context key of the request retry counter.
+type retriesKey struct{}
+
+// CountRetry is a hook for http.RoundTripper implementations
+// that retry requests, such as transport.Retry. A transport
+// calls it with the request context each time it resends the
+// request, and the Client reports the count in the Response
+// Retries field. It does nothing if the request was not sent
+// by a Client.
+func CountRetry(ctx context.Context) {
+	if retries, ok := ctx.Value(retriesKey{}).(*int); ok {
+		*retries++
+	}
+}
+
+// limitedBody wraps a response body and returns
+// ErrResponseTooLarge once more than the maximum number
+// of bytes is read.
+type limitedBody struct {
+	io.Closer
+	reader *io.LimitedReader
+}
+
+func newLimitedBody(body io.ReadCloser, max int64) *limitedBody {
+	return &limitedBody{
+		Closer: body,
+		reader: &io.LimitedReader{R: body, N: max + 1},
+	}
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.reader.Read(p)
+	if b.reader.N <= 0 {
+		return n, ErrResponseTooLarge
+	}
+	return n, err
 }
 
 // newResponse creates a new Response for the provided
@@ -5,7 +5,9 @@
 package scm
 
 import (
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -39,3 +41,19 @@ func TestResponse(t *testing.T) {
 		t.Errorf("Want rel next %d, got %d", want, got)
 	}
 }
+
+func TestLimitedBody(t *testing.T) {
+	body := newLimitedBody(ioutil.NopCloser(strings.NewReader("hello world")), 5)
+	if _, err := ioutil.ReadAll(body); err != ErrResponseTooLarge {
+		t.Errorf("Want response too large error, got %v", err)
+	}
+
+	body = newLimitedBody(ioutil.NopCloser(strings.NewReader("hello")), 5)
+	got, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Errorf("Want body within the limit, got %v", err)
+	}
+	if string(got) != "hello" {
+		t.Errorf("Want body %q, got %q", "hello", got)
+	}
+}
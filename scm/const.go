@@ -6,6 +6,8 @@ package scm
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 )
 
 // State represents the commit state.
@@ -44,6 +46,51 @@ func (s State) String() string {
 	}
 }
 
+// ParseState returns the State for the given name. The
+// name is case insensitive and may be any value returned
+// by State.String, or the alternate spelling canceled.
+func ParseState(s string) (State, error) {
+	switch strings.ToLower(s) {
+	case "unknown":
+		return StateUnknown, nil
+	case "pending":
+		return StatePending, nil
+	case "running":
+		return StateRunning, nil
+	case "success":
+		return StateSuccess, nil
+	case "failure":
+		return StateFailure, nil
+	case "cancelled", "canceled":
+		return StateCanceled, nil
+	case "error":
+		return StateError, nil
+	default:
+		return StateUnknown, fmt.Errorf("unknown state %q", s)
+	}
+}
+
+// CombineStates returns the combined state of the commit
+// states. The combined state is failure if any state is a
+// failure, error or canceled, pending if any state is not yet
+// successful or there are no states, and success otherwise.
+func CombineStates(states ...State) State {
+	if len(states) == 0 {
+		return StatePending
+	}
+	combined := StateSuccess
+	for _, state := range states {
+		switch state {
+		case StateFailure, StateError, StateCanceled:
+			return StateFailure
+		case StateSuccess:
+		default:
+			combined = StatePending
+		}
+	}
+	return combined
+}
+
 // Action identifies webhook actions.
 type Action int
 
@@ -71,6 +118,10 @@ const (
 	ActionEdited
 	ActionSubmitted
 	ActionDismissed
+
+	// members and teams
+	ActionAdd
+	ActionRemove
 )
 
 // String returns the string representation of Action.
@@ -112,6 +163,10 @@ func (a Action) String() (s string) {
 		return "review_request_removed"
 	case ActionReadyForReview:
 		return "ready_for_review"
+	case ActionAdd:
+		return "added"
+	case ActionRemove:
+		return "removed"
 	default:
 		return
 	}
@@ -149,6 +204,10 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 		*a = ActionSync
 	case "merged":
 		*a = ActionMerge
+	case "added":
+		*a = ActionAdd
+	case "removed":
+		*a = ActionRemove
 	}
 	return nil
 }
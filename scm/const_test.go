@@ -0,0 +1,78 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "testing"
+
+func TestStateRoundTrip(t *testing.T) {
+	states := []State{
+		StateUnknown,
+		StatePending,
+		StateRunning,
+		StateSuccess,
+		StateFailure,
+		StateCanceled,
+		StateError,
+	}
+	for _, state := range states {
+		got, err := ParseState(state.String())
+		if err != nil {
+			t.Errorf("Want state %s parsed, got error %s", state, err)
+			continue
+		}
+		if got != state {
+			t.Errorf("Want state %s, got %s", state, got)
+		}
+	}
+}
+
+func TestParseState(t *testing.T) {
+	tests := []struct {
+		src string
+		dst State
+	}{
+		{"canceled", StateCanceled},
+		{"SUCCESS", StateSuccess},
+		{"Failure", StateFailure},
+	}
+	for _, test := range tests {
+		got, err := ParseState(test.src)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got != test.dst {
+			t.Errorf("Want state %q parsed as %s, got %s", test.src, test.dst, got)
+		}
+	}
+}
+
+func TestParseState_Invalid(t *testing.T) {
+	got, err := ParseState("invalid")
+	if err == nil {
+		t.Errorf("Expect error parsing an invalid state")
+	}
+	if got != StateUnknown {
+		t.Errorf("Want invalid state parsed as %s, got %s", StateUnknown, got)
+	}
+}
+
+func TestCombineStates(t *testing.T) {
+	tests := []struct {
+		src []State
+		dst State
+	}{
+		{nil, StatePending},
+		{[]State{StateSuccess, StateSuccess}, StateSuccess},
+		{[]State{StateSuccess, StateRunning}, StatePending},
+		{[]State{StatePending, StateError}, StateFailure},
+		{[]State{StateSuccess, StateCanceled}, StateFailure},
+	}
+	for _, test := range tests {
+		if got := CombineStates(test.src...); got != test.dst {
+			t.Errorf("Want states %v combined as %s, got %s", test.src, test.dst, got)
+		}
+	}
+}
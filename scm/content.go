@@ -13,6 +13,16 @@ type (
 		Data []byte
 	}
 
+	// FileEntry describes a file or directory in a
+	// repository tree.
+	FileEntry struct {
+		Name string
+		Path string
+		Type string // file, dir, symlink or submodule
+		Size int
+		Sha  string
+	}
+
 	// ContentParams provide parameters for creating and
 	// updating repository content.
 	ContentParams struct {
@@ -22,6 +32,28 @@ type (
 		Data    []byte
 	}
 
+	// BatchCommitInput provides parameters for committing
+	// multiple file changes to a branch in a single commit.
+	BatchCommitInput struct {
+		Branch  string
+		Message string
+		Actions []*FileAction
+	}
+
+	// FileAction describes a change to a repository file
+	// in a batch commit.
+	FileAction struct {
+		Action string // create, update or delete
+		Path   string
+		Data   []byte
+
+		// Mode is the git file mode, such as 100755 for an
+		// executable or 120000 for a symlink. When empty an
+		// updated file keeps its mode, and a created file is
+		// a regular file.
+		Mode string
+	}
+
 	// ContentService provides access to repositroy content.
 	ContentService interface {
 		// Find returns the repository file content by path.
@@ -35,5 +67,15 @@ type (
 
 		// Delete deletes a reository file.
 		Delete(ctx context.Context, repo, path, ref string) (*Response, error)
+
+		// Tree returns the repository tree at the ref. If
+		// recursive is true, the entries of all
+		// subdirectories are included.
+		Tree(ctx context.Context, repo, ref string, recursive bool) ([]*FileEntry, *Response, error)
+
+		// BatchUpdate creates, updates and deletes repository
+		// files in a single commit on the input branch, and
+		// returns the new commit.
+		BatchUpdate(ctx context.Context, repo string, input *BatchCommitInput) (*Commit, *Response, error)
 	}
 )
@@ -10,34 +10,39 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net/url"
-	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/baseurl"
 )
 
 // New returns a new Bitbucket API client.
 func New(uri string) (*scm.Client, error) {
-	base, err := url.Parse(uri)
+	base, err := baseurl.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(base.Path, "/") {
-		base.Path = base.Path + "/"
-	}
 	client := &wrapper{new(scm.Client)}
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverBitbucket
 	client.Contents = &contentService{client}
+	client.Gists = &gistService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Misc = &miscService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Rulesets = &rulesetService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.SetDoFunc(client.do)
+	client.SetCapabilities(scm.Capabilities{
+		Statuses: true,
+	})
 	return client.Client, nil
 }
 
@@ -83,6 +88,8 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// error response.
 	if res.Status == 401 {
 		return res, scm.ErrNotAuthorized
+	} else if res.Status == 404 {
+		return res, scm.ErrNotFound
 	} else if res.Status > 300 {
 		err := new(Error)
 		json.NewDecoder(res.Body).Decode(err)
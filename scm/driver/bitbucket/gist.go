@@ -0,0 +1,31 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type gistService struct {
+	client *wrapper
+}
+
+func (s *gistService) Find(ctx context.Context, id string) (*scm.Gist, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gistService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Gist, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gistService) Create(ctx context.Context, input *scm.GistInput) (*scm.Gist, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gistService) Delete(ctx context.Context, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -24,6 +24,18 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	panic("implement me")
 }
 
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CherryPick(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Revert(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/branches/%s", repo, name)
 	out := new(branch)
@@ -38,6 +50,10 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
 	out := new(branch)
@@ -206,6 +222,9 @@ func convertCommitList(from *commits) []*scm.Commit {
 	return to
 }
 
+// helper function to convert a bitbucket commit. The committer
+// is not reported by the bitbucket api and is assumed to be the
+// author of the commit.
 func convertCommit(from *commit) *scm.Commit {
 	return &scm.Commit{
 		Message: from.Message,
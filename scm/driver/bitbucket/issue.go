@@ -18,6 +18,10 @@ func (s *issueService) AssignIssue(ctx context.Context, repo string, number int,
 	panic("implement me")
 }
 
+func (s *issueService) SetAssignees(context.Context, string, int, []string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *issueService) UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -54,6 +58,10 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *issueService) ListAllComments(context.Context, string, int) ([]*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -70,10 +78,22 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) LockWithOptions(ctx context.Context, repo string, number int, opts scm.LockOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *issueService) Transfer(ctx context.Context, repo string, number int, targetRepo string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -0,0 +1,23 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type miscService struct {
+	client *wrapper
+}
+
+func (s *miscService) RenderMarkdown(ctx context.Context, repo, text string) (string, *scm.Response, error) {
+	return "", nil, scm.ErrNotSupported
+}
+
+func (s *miscService) ListEmojis(ctx context.Context) (map[string]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -65,3 +65,7 @@ func convertOrganization(from *organization) *scm.Organization {
 		Avatar: fmt.Sprintf("https://bitbucket.org/account/%s/avatar/32/", from.Login),
 	}
 }
+
+func (s *organizationService) ListAllHooks(context.Context, string) (map[string][]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -7,10 +7,12 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/batch"
 )
 
 type repository struct {
@@ -55,6 +57,10 @@ type hookInput struct {
 	Events      []string `json:"events"`
 }
 
+// maxStatusLabelLength is the maximum length of a build status
+// key accepted by Bitbucket Cloud.
+const maxStatusLabelLength = 40
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -71,10 +77,86 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user strin
 	panic("implement me")
 }
 
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *repositoryService) ListCollaboratorsWithPermission(context.Context, string, scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListContributors(context.Context, string, scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListInvitations(context.Context, string, scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteInvitation(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UploadAttachment(context.Context, string, string, io.Reader) (*scm.Attachment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Create(context.Context, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) CreateFromTemplate(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Star(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Unstar(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetArchived(context.Context, string, bool) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) TriggerPipeline(context.Context, string, string, map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DispatchWorkflow(context.Context, string, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Dispatch(context.Context, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListSecrets(context.Context, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetSecret(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteSecret(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DownloadArchive(context.Context, string, string, string) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
 	panic("implement me")
 }
@@ -87,6 +169,23 @@ func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Reposit
 	return convertRepository(out), res, err
 }
 
+func (s *repositoryService) FindWithViewerState(context.Context, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Exists returns true if the repository exists and is visible
+// to the authenticated user.
+func (s *repositoryService) Exists(ctx context.Context, repo string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	res, err := s.client.do(ctx, "HEAD", path, nil, nil)
+	if err == scm.ErrNotFound {
+		return false, res, nil
+	} else if err != nil {
+		return false, res, err
+	}
+	return true, res, nil
+}
+
 // FindHook returns a repository hook.
 func (s *repositoryService) FindHook(ctx context.Context, repo string, id string) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/hooks/%s", repo, id)
@@ -115,6 +214,13 @@ func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*
 	return convertRepositoryList(out), res, err
 }
 
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	if opts.Affiliation != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	return s.List(ctx, scm.ListOptions{Page: opts.Page, Size: opts.Size})
+}
+
 // ListHooks returns a list or repository hooks.
 func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/hooks?%s", repo, encodeListOptions(opts))
@@ -159,8 +265,11 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 
 // CreateStatus creates a new commit status.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+	if err := scm.CheckStatusLabel(input.Label, maxStatusLabelLength); err != nil {
+		return nil, nil, err
+	}
 	path := fmt.Sprintf("2.0/repositories/%s/commit/%s/statuses/build", repo, ref)
-	in := &status{
+	in := &statusInput{
 		State: convertFromState(input.State),
 		Desc:  input.Desc,
 		Key:   input.Label,
@@ -171,6 +280,17 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 	return convertStatus(out), res, err
 }
 
+func (s *repositoryService) CreateStatuses(ctx context.Context, repo, ref string, inputs []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	statuses, err := batch.CreateStatuses(ctx, inputs, func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		return s.CreateStatus(ctx, repo, ref, input)
+	})
+	return statuses, nil, err
+}
+
+func (s *repositoryService) DeleteStatus(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // DeleteHook deletes a repository webhook.
 func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/hooks/%s", repo, id)
@@ -240,6 +360,7 @@ func convertHook(from *hook) *scm.Hook {
 		Target:     from.URL,
 		Events:     from.Events,
 		SkipVerify: from.SkipCertVerification,
+		Parsed:     parseHookEvents(from.Events),
 	}
 }
 
@@ -272,6 +393,34 @@ func convertHookEvents(from scm.HookEvents) []string {
 	return events
 }
 
+// helper function parses the native hook events into the
+// structured hook events.
+func parseHookEvents(from []string) scm.HookEvents {
+	var events scm.HookEvents
+	for _, event := range from {
+		switch event {
+		case "repo:push":
+			events.Push = true
+		case "pullrequest:updated",
+			"pullrequest:unapproved",
+			"pullrequest:approved",
+			"pullrequest:rejected",
+			"pullrequest:fulfilled",
+			"pullrequest:created":
+			events.PullRequest = true
+		case "pullrequest:comment_created",
+			"pullrequest:comment_updated",
+			"pullrequest:comment_deleted":
+			events.PullRequestComment = true
+		case "issues", "issue:created", "issue:updated":
+			events.Issue = true
+		case "issue:comment_created":
+			events.IssueComment = true
+		}
+	}
+	return events
+}
+
 type repositories struct {
 	pagination
 	Values []*repository `json:"values"`
@@ -283,6 +432,16 @@ type statuses struct {
 }
 
 type status struct {
+	State     string    `json:"state"`
+	Key       string    `json:"key"`
+	Name      string    `json:"name,omitempty"`
+	URL       string    `json:"url"`
+	Desc      string    `json:"description,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type statusInput struct {
 	State string `json:"state"`
 	Key   string `json:"key"`
 	Name  string `json:"name,omitempty"`
@@ -295,15 +454,18 @@ func convertStatusList(from *statuses) []*scm.Status {
 	for _, v := range from.Values {
 		to = append(to, convertStatus(v))
 	}
+	scm.SortStatuses(to)
 	return to
 }
 
 func convertStatus(from *status) *scm.Status {
 	return &scm.Status{
-		State:  convertState(from.State),
-		Label:  from.Key,
-		Desc:   from.Desc,
-		Target: from.URL,
+		State:   convertState(from.State),
+		Label:   from.Key,
+		Desc:    from.Desc,
+		Target:  from.URL,
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
 	}
 }
 
@@ -7,7 +7,9 @@ package bitbucket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -56,8 +58,8 @@ func TestRepositoryFind_NotFound(t *testing.T) {
 		t.Errorf("Expect not found message")
 	}
 
-	if got, want := err.Error(), "Repository dev/null not found"; got != want {
-		t.Errorf("Want error message %q, got %q", want, got)
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %q, got %q", scm.ErrNotFound, err)
 	}
 }
 
@@ -199,6 +201,35 @@ func TestStatusCreate(t *testing.T) {
 	}
 }
 
+func TestStatusCreate_LabelTooLong(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/statuses/build").
+		BodyString(`"key":"` + strings.Repeat("a", 40) + `"`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/status.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	for _, label := range []string{strings.Repeat("a", 41), strings.Repeat("a", 40)} {
+		_, _, err := client.Repositories.CreateStatus(context.Background(), "atlassian/stash-example-plugin", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", &scm.StatusInput{
+			Label: label,
+			State: scm.StateSuccess,
+		})
+		var tooLong scm.StatusLabelTooLong
+		switch {
+		case len(label) <= 40 && err != nil:
+			t.Errorf("Expect key of %d characters to be accepted, got %v", len(label), err)
+		case len(label) > 40 && (!errors.As(err, &tooLong) || tooLong.Max != 40):
+			t.Errorf("Expect StatusLabelTooLong error with a maximum of 40, got %v", err)
+		}
+	}
+	if gock.HasUnmatchedRequest() || !gock.IsDone() {
+		t.Errorf("Expect only the key within the maximum length to be sent")
+	}
+}
+
 func TestRepositoryHookFind(t *testing.T) {
 	defer gock.Off()
 
@@ -397,3 +428,20 @@ func TestConvertPerms(t *testing.T) {
 		}
 	}
 }
+
+func TestParseHookEvents(t *testing.T) {
+	tests := []scm.HookEvents{
+		{Push: true},
+		{PullRequest: true},
+		{PullRequestComment: true},
+		{Issue: true},
+		{IssueComment: true},
+	}
+	for i, in := range tests {
+		got := parseHookEvents(convertHookEvents(in))
+		if diff := cmp.Diff(got, in); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
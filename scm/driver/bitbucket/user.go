@@ -32,6 +32,14 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return "", nil, scm.ErrNotSupported
 }
 
+func (s *userService) AcceptInvitation(context.Context, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListStarred(context.Context, scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type user struct {
 	Login string `json:"username"`
 	Name  string `json:"display_name"`
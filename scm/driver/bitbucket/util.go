@@ -12,6 +12,16 @@ import (
 	"github.com/jenkins-x/go-scm/scm"
 )
 
+// maxPageSize is the largest pagelen value accepted by Bitbucket.
+const maxPageSize = 100
+
+func pageSize(size int) int {
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
+
 // regex for git author fields ("name <name@mail.tld>")
 var reGitMail = regexp.MustCompile("<(.*)>")
 
@@ -30,7 +40,7 @@ func encodeListOptions(opts scm.ListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("pagelen", strconv.Itoa(opts.Size))
+		params.Set("pagelen", strconv.Itoa(pageSize(opts.Size)))
 	}
 	return params.Encode()
 }
@@ -41,7 +51,7 @@ func encodeListRoleOptions(opts scm.ListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("pagelen", strconv.Itoa(opts.Size))
+		params.Set("pagelen", strconv.Itoa(pageSize(opts.Size)))
 	}
 	params.Set("role", "member")
 	return params.Encode()
@@ -53,7 +63,7 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("pagelen", strconv.Itoa(opts.Size))
+		params.Set("pagelen", strconv.Itoa(pageSize(opts.Size)))
 	}
 	return params.Encode()
 }
@@ -64,7 +74,7 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("pagelen", strconv.Itoa(opts.Size))
+		params.Set("pagelen", strconv.Itoa(pageSize(opts.Size)))
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
@@ -80,7 +90,7 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("pagelen", strconv.Itoa(opts.Size))
+		params.Set("pagelen", strconv.Itoa(pageSize(opts.Size)))
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
@@ -22,6 +22,18 @@ func Test_encodeListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeListOptions_MaxPageSize(t *testing.T) {
+	opts := scm.ListOptions{
+		Page: 1,
+		Size: 1000,
+	}
+	want := "page=1&pagelen=100"
+	got := encodeListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeCommitListOptions(t *testing.T) {
 	opts := scm.CommitListOptions{
 		Page: 10,
@@ -55,6 +55,9 @@ type Data struct {
 	RefsDeleted []DeletedRef
 
 	UserPermissions map[string]map[string]string
+
+	// Repositories returned by the repository search
+	Repositories []*scm.Repository
 }
 
 type DeletedRef struct {
@@ -88,5 +91,6 @@ func NewData() *Data {
 		CommentReactionsAdded: []string{},
 		AssigneesAdded:        []string{},
 		UserPermissions:       map[string]map[string]string{},
+		Repositories:          []*scm.Repository{},
 	}
 }
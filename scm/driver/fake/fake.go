@@ -25,6 +25,7 @@ func NewDefault() (*scm.Client, *Data) {
 	client.PullRequests = &pullService{client: client, data: data}
 	client.Repositories = &repositoryService{client: client, data: data}
 	client.Reviews = &reviewService{client: client, data: data}
+	client.Search = &searchService{client: client, data: data}
 
 	// TODO
 	/*
@@ -30,6 +30,18 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	return nil, nil
 }
 
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *gitService) CherryPick(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *gitService) Revert(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	panic("implement me")
 }
@@ -39,6 +51,10 @@ func (s *gitService) FindCommit(ctx context.Context, repo, SHA string) (*scm.Com
 	return f.Commits[SHA], nil, nil
 }
 
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	panic("implement me")
 }
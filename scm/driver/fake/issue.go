@@ -30,7 +30,7 @@ func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.
 			}
 		}
 	}
-	return nil, nil, nil
+	return nil, nil, scm.ErrNotFound
 }
 
 func (s *issueService) ListLabels(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
@@ -108,6 +108,10 @@ func (s *issueService) AssignIssue(ctx context.Context, repo string, number int,
 	return nil, m
 }
 
+func (s *issueService) SetAssignees(context.Context, string, int, []string) (*scm.Issue, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *issueService) UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -125,6 +129,23 @@ func (s *issueService) ListComments(ctx context.Context, repo string, number int
 	return append([]*scm.Comment{}, f.IssueComments[number]...), nil, nil
 }
 
+func (s *issueService) ListAllComments(ctx context.Context, repo string, number int) ([]*scm.Comment, *scm.Response, error) {
+	f := s.data
+	all := append([]*scm.Comment{}, f.IssueComments[number]...)
+	for _, review := range f.Reviews[number] {
+		all = append(all, &scm.Comment{
+			ID:      review.ID,
+			Body:    review.Body,
+			Author:  review.Author,
+			Link:    review.Link,
+			Created: review.Created,
+			Updated: review.Updated,
+		})
+	}
+	scm.SortComments(all)
+	return all, nil, nil
+}
+
 func (s *issueService) Create(context.Context, string, *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	panic("implement me")
 }
@@ -160,10 +181,22 @@ func (s *issueService) Close(context.Context, string, int) (*scm.Response, error
 	panic("implement me")
 }
 
+func (s *issueService) Reopen(context.Context, string, int) (*scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *issueService) Lock(context.Context, string, int) (*scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *issueService) LockWithOptions(context.Context, string, int, scm.LockOptions) (*scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *issueService) Unlock(context.Context, string, int) (*scm.Response, error) {
 	panic("implement me")
 }
+
+func (s *issueService) Transfer(context.Context, string, int, string) (*scm.Issue, *scm.Response, error) {
+	panic("implement me")
+}
@@ -66,3 +66,7 @@ func (s *organizationService) ListTeamMembers(ctx context.Context, teamID int, r
 	}
 	return members, nil, nil
 }
+
+func (s *organizationService) ListAllHooks(context.Context, string) (map[string][]*scm.Hook, *scm.Response, error) {
+	panic("implement me")
+}
@@ -25,10 +25,26 @@ func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comme
 	panic("implement me")
 }
 
+func (s *pullService) FindDiff(context.Context, string, int) ([]byte, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *pullService) List(context.Context, string, scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *pullService) Create(context.Context, string, *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *pullService) ListMine(context.Context, scm.ListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *pullService) ListForCommit(context.Context, string, string) ([]*scm.PullRequest, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	f := s.data
 	return f.PullRequestChanges[number], nil, nil
@@ -47,6 +63,22 @@ func (s *pullService) Close(context.Context, string, int) (*scm.Response, error)
 	panic("implement me")
 }
 
+func (s *pullService) Rebase(context.Context, string, int) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *pullService) FindCombinedStatus(context.Context, string, int) (*scm.CombinedStatus, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *pullService) ListLinkedIssues(context.Context, string, int) ([]*scm.Issue, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *pullService) ApprovalCount(context.Context, string, int) (*scm.Approvals, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *pullService) CreateComment(ctx context.Context, repo string, number int, comment *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	f := s.data
 	f.IssueCommentsAdded = append(f.IssueCommentsAdded, fmt.Sprintf("%s#%d:%s", repo, number, comment.Body))
@@ -2,6 +2,7 @@ package fake
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -40,7 +41,7 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, login stri
 	return false, nil, nil
 }
 
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
 	f := s.data
 	result := make([]scm.User, 0, len(f.Collaborators))
 	for _, login := range f.Collaborators {
@@ -49,10 +50,110 @@ func (s *repositoryService) ListCollaborators(ctx context.Context, repo string)
 	return result, nil, nil
 }
 
+func (s *repositoryService) ListCollaboratorsWithPermission(context.Context, string, scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) ListContributors(context.Context, string, scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, login, permission string) (bool, *scm.Response, error) {
+	f := s.data
+	normed := NormLogin(login)
+	for _, collab := range f.Collaborators {
+		if NormLogin(collab) == normed {
+			return false, nil, nil
+		}
+	}
+	f.Collaborators = append(f.Collaborators, login)
+	return false, nil, nil
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, login string) (*scm.Response, error) {
+	f := s.data
+	normed := NormLogin(login)
+	for i, collab := range f.Collaborators {
+		if NormLogin(collab) == normed {
+			f.Collaborators = append(f.Collaborators[:i], f.Collaborators[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *repositoryService) ListInvitations(context.Context, string, scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) DeleteInvitation(context.Context, string, int) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) UploadAttachment(context.Context, string, string, io.Reader) (*scm.Attachment, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) Create(context.Context, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) CreateFromTemplate(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) Star(context.Context, string) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) Unstar(context.Context, string) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) SetArchived(context.Context, string, bool) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) TriggerPipeline(context.Context, string, string, map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) DispatchWorkflow(context.Context, string, string, string, map[string]interface{}) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) Dispatch(context.Context, string, string, map[string]interface{}) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) ListSecrets(context.Context, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) SetSecret(context.Context, string, string, string) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) DeleteSecret(context.Context, string, string) (*scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) DownloadArchive(context.Context, string, string, string) (io.ReadCloser, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *repositoryService) Find(context.Context, string) (*scm.Repository, *scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *repositoryService) FindWithViewerState(context.Context, string) (*scm.Repository, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) Exists(context.Context, string) (bool, *scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *repositoryService) FindHook(context.Context, string, string) (*scm.Hook, *scm.Response, error) {
 	panic("implement me")
 }
@@ -65,6 +166,10 @@ func (s *repositoryService) List(context.Context, scm.ListOptions) ([]*scm.Repos
 	panic("implement me")
 }
 
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	return s.List(ctx, scm.ListOptions{Page: opts.Page, Size: opts.Size})
+}
+
 func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
 	f := s.data
 	la := []*scm.Label{}
@@ -90,6 +195,14 @@ func (s *repositoryService) CreateStatus(context.Context, string, string, *scm.S
 	panic("implement me")
 }
 
+func (s *repositoryService) CreateStatuses(context.Context, string, string, []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	panic("implement me")
+}
+
+func (s *repositoryService) DeleteStatus(context.Context, string, string, string) (*scm.Response, error) {
+	panic("implement me")
+}
+
 func (s *repositoryService) DeleteHook(context.Context, string, string) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -29,6 +29,17 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opt s
 	return append([]*scm.Review{}, f.Reviews[number]...), nil, nil
 }
 
+func (s *reviewService) ListComments(ctx context.Context, repo string, number int, opt scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
+	f := s.data
+	to := []*scm.Review{}
+	for _, review := range f.Reviews[number] {
+		if review.Path != "" {
+			to = append(to, review)
+		}
+	}
+	return to, nil, nil
+}
+
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	f := s.data
 	review := &scm.Review{
@@ -41,6 +52,93 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 	return review, nil, nil
 }
 
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.Review, *scm.Response, error) {
+	f := s.data
+	review := &scm.Review{
+		ID:     f.ReviewID,
+		Author: scm.User{Login: botName},
+		Body:   input.Body,
+		Path:   input.Path,
+		Line:   input.Line,
+		Sha:    input.Sha,
+	}
+	f.Reviews[number] = append(f.Reviews[number], review)
+	f.ReviewID++
+	return review, nil, nil
+}
+
 func (s *reviewService) Delete(context.Context, string, int, int) (*scm.Response, error) {
 	panic("implement me")
 }
+
+func (s *reviewService) EditComment(ctx context.Context, repo string, number, id int, body string) (*scm.Review, *scm.Response, error) {
+	f := s.data
+	for _, review := range f.Reviews[number] {
+		if review.ID == id {
+			review.Body = body
+			return review, nil, nil
+		}
+	}
+	return nil, nil, scm.ErrNotFound
+}
+
+func (s *reviewService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	f := s.data
+	reviews := f.Reviews[number]
+	for i, review := range reviews {
+		if review.ID == id {
+			f.Reviews[number] = append(reviews[:i], reviews[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, scm.ErrNotFound
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int) ([]*scm.ReviewThread, *scm.Response, error) {
+	threads := []*scm.ReviewThread{}
+	index := map[string]*scm.ReviewThread{}
+	for _, review := range s.data.Reviews[number] {
+		if review.ThreadID == "" {
+			continue
+		}
+		thread, ok := index[review.ThreadID]
+		if !ok {
+			thread = &scm.ReviewThread{
+				ID:       review.ThreadID,
+				Path:     review.Path,
+				Line:     review.Line,
+				Resolved: review.Resolved,
+			}
+			index[review.ThreadID] = thread
+			threads = append(threads, thread)
+		}
+		thread.Comments = append(thread.Comments, review)
+	}
+	return threads, nil, nil
+}
+
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return s.setResolved(number, threadID, true)
+}
+
+func (s *reviewService) UnresolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return s.setResolved(number, threadID, false)
+}
+
+func (s *reviewService) setResolved(number int, threadID string, resolved bool) (*scm.Response, error) {
+	found := false
+	for _, review := range s.data.Reviews[number] {
+		if review.ThreadID == threadID {
+			review.Resolved = resolved
+			found = true
+		}
+	}
+	if !found {
+		return nil, scm.ErrNotFound
+	}
+	return nil, nil
+}
+
+func (s *reviewService) CreateCommentReply(context.Context, string, int, int, string) (*scm.Comment, *scm.Response, error) {
+	panic("implement me")
+}
@@ -0,0 +1,44 @@
+package fake
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+	data   *Data
+}
+
+// Repositories returns the repositories in Data.Repositories whose
+// full name contains every term of the query. Search qualifiers,
+// such as topic:, are ignored.
+func (s *searchService) Repositories(ctx context.Context, query string, opts scm.ListOptions) (*scm.RepositorySearchResult, *scm.Response, error) {
+	terms := []string{}
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		if !strings.Contains(term, ":") {
+			terms = append(terms, term)
+		}
+	}
+	out := &scm.RepositorySearchResult{
+		Repositories: []*scm.Repository{},
+	}
+	for _, repo := range s.data.Repositories {
+		if matchTerms(strings.ToLower(repo.FullName), terms) {
+			out.Repositories = append(out.Repositories, repo)
+		}
+	}
+	out.Total = len(out.Repositories)
+	return out, nil, nil
+}
+
+func matchTerms(s string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(s, term) {
+			return false
+		}
+	}
+	return true
+}
@@ -26,7 +26,26 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	return &scm.Content{
 		Path: path,
 		Data: buf.Bytes(),
-	}, res, err
+	}, res, s.checkEmpty(ctx, repo, err)
+}
+
+// checkEmpty returns ErrEmptyRepository if the not found error
+// is caused by the repository having no commits. Gitea responds
+// to raw file requests for empty repositories with 404 Not Found,
+// so the repository is requested to tell the two apart.
+func (s *contentService) checkEmpty(ctx context.Context, repo string, err error) error {
+	if err != scm.ErrNotFound {
+		return err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	out := new(repository)
+	if _, perr := s.client.do(ctx, "GET", path, nil, out); perr != nil {
+		return err
+	}
+	if out.Empty {
+		return scm.ErrEmptyRepository
+	}
+	return err
 }
 
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
@@ -40,3 +59,11 @@ func (s *contentService) Update(ctx context.Context, repo, path string, params *
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *contentService) Tree(ctx context.Context, repo, ref string, recursive bool) ([]*scm.FileEntry, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *contentService) BatchUpdate(ctx context.Context, repo string, input *scm.BatchCommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -40,6 +40,28 @@ func TestContentFind(t *testing.T) {
 	}
 }
 
+func TestContentFind_EmptyRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/raw/master/README.md").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message":"object does not exist"}`)
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_empty.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Contents.Find(context.Background(), "go-gitea/gitea", "README.md", "master")
+	if err != scm.ErrEmptyRepository {
+		t.Errorf("Expect Empty Repository error, got %v", err)
+	}
+}
+
 func TestContentCreate(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
 	_, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", nil)
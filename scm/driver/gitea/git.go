@@ -24,6 +24,18 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	panic("implement me")
 }
 
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CherryPick(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Revert(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -38,6 +50,10 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommitInfo(out), res, err
 }
 
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -93,9 +109,10 @@ type (
 
 	// gitea signature object.
 	signature struct {
-		Name     string `json:"name"`
-		Email    string `json:"email"`
-		Username string `json:"username"`
+		Name     string    `json:"name"`
+		Email    string    `json:"email"`
+		Username string    `json:"username"`
+		Date     time.Time `json:"date"`
 	}
 )
 
@@ -132,24 +149,28 @@ func convertCommitInfo(src *commitInfo) *scm.Commit {
 		Sha:       src.Sha,
 		Link:      src.Commit.URL,
 		Message:   src.Commit.Message,
-		Author:    convertUserSignature(src.Author),
-		Committer: convertUserSignature(src.Committer),
+		Author:    convertCommitSignature(src.Commit.Author, src.Author),
+		Committer: convertCommitSignature(src.Commit.Committer, src.Committer),
 	}
 }
 
-func convertSignature(src signature) scm.Signature {
+// helper function to convert the git signature of a commit,
+// populating the login and avatar from the matching gitea
+// account when the email is linked to a user.
+func convertCommitSignature(src signature, account user) scm.Signature {
 	return scm.Signature{
-		Login: src.Username,
-		Email: src.Email,
-		Name:  src.Name,
+		Name:   src.Name,
+		Email:  src.Email,
+		Date:   src.Date,
+		Login:  userLogin(&account),
+		Avatar: account.Avatar,
 	}
 }
 
-func convertUserSignature(src user) scm.Signature {
+func convertSignature(src signature) scm.Signature {
 	return scm.Signature{
-		Login:  userLogin(&src),
-		Email:  src.Email,
-		Name:   src.Fullname,
-		Avatar: src.Avatar,
+		Login: src.Username,
+		Email: src.Email,
+		Name:  src.Name,
 	}
 }
@@ -5,7 +5,12 @@
 // package gitea implements a Gogs client.
 package gitea
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+)
 
 func TestClient(t *testing.T) {
 	client, err := New("https://try.gitea.io")
@@ -27,6 +32,20 @@ func TestClient_Base(t *testing.T) {
 	}
 }
 
+func TestClient_Capabilities(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	want := scm.Capabilities{
+		Issues:   true,
+		Statuses: true,
+		Stars:    true,
+		Archives: true,
+	}
+	if diff := cmp.Diff(client.Capabilities(), want); diff != "" {
+		t.Errorf("Unexpected Capabilities")
+		t.Log(diff)
+	}
+}
+
 func TestClient_Error(t *testing.T) {
 	_, err := New("http://a b.com/")
 	if err == nil {
@@ -20,6 +20,10 @@ func (s *issueService) AssignIssue(ctx context.Context, repo string, number int,
 	panic("implement me")
 }
 
+func (s *issueService) SetAssignees(context.Context, string, int, []string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *issueService) UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -51,8 +55,8 @@ func (s *issueService) FindComment(ctx context.Context, repo string, index, id i
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *issueService) List(ctx context.Context, repo string, _ scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
+func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues?%s", repo, encodeIssueListOptions(opts))
 	out := []*issue{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertIssueList(out), res, err
@@ -65,11 +69,27 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 	return convertIssueCommentList(out), res, err
 }
 
+// ListAllComments returns the issue comments, which include the
+// pull request conversation comments. Inline review comments are
+// not supported.
+func (s *issueService) ListAllComments(ctx context.Context, repo string, number int) ([]*scm.Comment, *scm.Response, error) {
+	comments, res, err := s.ListComments(ctx, repo, number, scm.ListOptions{})
+	if err != nil {
+		return nil, res, err
+	}
+	scm.SortComments(comments)
+	return comments, res, nil
+}
+
+// Create creates a new issue. The labels are ignored, as
+// Gitea expects label ids rather than names.
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: input.Milestone,
+		Assignees: input.Assignees,
 	}
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -95,14 +115,30 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, &data, out)
+	return res, err
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) LockWithOptions(ctx context.Context, repo string, number int, opts scm.LockOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Transfer(ctx context.Context, repo string, number int, targetRepo string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -128,8 +164,10 @@ type (
 
 	// gitea issue request object.
 	issueInput struct {
-		Title string `json:"title"`
-		Body  string `json:"body"`
+		Title     string   `json:"title"`
+		Body      string   `json:"body"`
+		Milestone int      `json:"milestone,omitempty"`
+		Assignees []string `json:"assignees,omitempty"`
 	}
 
 	// gitea issue comment response object.
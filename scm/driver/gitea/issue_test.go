@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
@@ -69,6 +70,24 @@ func TestIssueList(t *testing.T) {
 	}
 }
 
+func TestIssueList_Since(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues").
+		MatchParam("since", "2019-05-01T10:30:00Z").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://try.gitea.io")
+	since := time.Date(2019, time.May, 1, 10, 30, 0, 0, time.UTC)
+	_, _, err := client.Issues.List(context.Background(), "go-gitea/gitea", scm.IssueListOptions{Since: since})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -99,6 +118,31 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCreate_Milestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues").
+		BodyString(`"milestone":1,"assignees":\["octocat"\]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Body:      "I'm having a problem with this.",
+		Milestone: 1,
+		Assignees: []string{"octocat"},
+		Labels:    []string{"bug"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Issues.Create(context.Background(), "go-gitea/gitea", &input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueClose(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
 	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
@@ -107,6 +151,23 @@ func TestIssueClose(t *testing.T) {
 	}
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		BodyString(`{"state":"open"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.Reopen(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueLock(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
 	_, err := client.Issues.Lock(context.Background(), "gogits/go-gogs-client", 1)
@@ -0,0 +1,43 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type miscService struct {
+	client *wrapper
+}
+
+// RenderMarkdown renders the markdown text to HTML. Issue
+// references are linked in the repository context, when the
+// repository is provided.
+func (s *miscService) RenderMarkdown(ctx context.Context, repo, text string) (string, *scm.Response, error) {
+	in := &markdownInput{
+		Text: text,
+		Mode: "markdown",
+	}
+	if repo != "" {
+		in.Mode = "gfm"
+		in.Context = repo
+	}
+	out := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "POST", "api/v1/markdown", in, out)
+	return out.String(), res, err
+}
+
+func (s *miscService) ListEmojis(ctx context.Context) (map[string]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type markdownInput struct {
+	Text    string `json:"Text"`
+	Mode    string `json:"Mode"`
+	Context string `json:"Context,omitempty"`
+}
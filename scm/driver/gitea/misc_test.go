@@ -0,0 +1,36 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestMiscRenderMarkdown(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/markdown").
+		BodyString(`{"Text":"Fixes #1","Mode":"gfm","Context":"jcitizen/my-repo"}`).
+		Reply(200).
+		Type("text/html").
+		File("testdata/markdown.html")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Misc.RenderMarkdown(context.Background(), "jcitizen/my-repo", "Fixes #1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/markdown.html")
+	if got != string(want) {
+		t.Errorf("Want html %q, got %q", want, got)
+	}
+}
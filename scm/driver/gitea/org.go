@@ -67,3 +67,7 @@ func convertOrg(from *org) *scm.Organization {
 		Avatar: from.Avatar,
 	}
 }
+
+func (s *organizationService) ListAllHooks(context.Context, string) (map[string][]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -23,6 +23,10 @@ func (s *pullService) Find(ctx context.Context, repo string, index int) (*scm.Pu
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) FindDiff(context.Context, string, int) ([]byte, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -34,10 +38,22 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequests(out), res, err
 }
 
+func (s *pullService) Create(context.Context, string, *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListMine(ctx context.Context, opts scm.ListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) ListForCommit(context.Context, string, string) ([]*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) ListChanges(context.Context, string, int, scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -60,6 +76,28 @@ func (s *pullService) Close(context.Context, string, int) (*scm.Response, error)
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Rebase(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// FindCombinedStatus returns the combined status of the pull
+// request head commit.
+func (s *pullService) FindCombinedStatus(ctx context.Context, repo string, number int) (*scm.CombinedStatus, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.client.Repositories.FindCombinedStatus(ctx, repo, pr.Sha)
+}
+
+func (s *pullService) ListLinkedIssues(context.Context, string, int) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ApprovalCount(context.Context, string, int) (*scm.Approvals, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -77,6 +77,41 @@ func TestPullRequestClose(t *testing.T) {
 	}
 }
 
+func TestPullRequestFindCombinedStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/commits/4f5e7d8f15cf79387cfd8a0d30c58855ab61e138/status").
+		Reply(200).
+		Type("application/json").
+		File("testdata/combined_status.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.FindCombinedStatus(context.Background(), "jcitizen/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CombinedStatus)
+	raw, _ := ioutil.ReadFile("testdata/combined_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
 func TestPullRequestMerge(t *testing.T) {
 	defer gock.Off()
 
@@ -7,11 +7,13 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/batch"
 )
 
 type repositoryService struct {
@@ -19,7 +21,10 @@ type repositoryService struct {
 }
 
 func (s *repositoryService) FindCombinedStatus(ctx context.Context, repo, ref string) (*scm.CombinedStatus, *scm.Response, error) {
-	panic("implement me")
+	path := fmt.Sprintf("api/v1/repos/%s/commits/%s/status", repo, ref)
+	out := new(combinedStatus)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCombinedStatus(out), res, err
 }
 
 func (s *repositoryService) FindUserPermission(ctx context.Context, repo string, user string) (string, *scm.Response, error) {
@@ -30,10 +35,117 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user strin
 	panic("implement me")
 }
 
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *repositoryService) ListCollaboratorsWithPermission(context.Context, string, scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListContributors returns scm.ErrNotSupported. The Gitea API has
+// no contributors endpoint; the contributor graph is only rendered
+// by the web interface.
+func (s *repositoryService) ListContributors(context.Context, string, scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListInvitations(context.Context, string, scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteInvitation(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UploadAttachment(context.Context, string, string, io.Reader) (*scm.Attachment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Create creates a new repository, in the organization when a
+// namespace is given. The gitignore and license templates are
+// only applied when the repository is auto initialized.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	path := "api/v1/user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("api/v1/orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     input.Private,
+		AutoInit:    input.AutoInit,
+		Gitignores:  input.GitignoreTemplate,
+		License:     input.LicenseTemplate,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) CreateFromTemplate(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Star(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/starred/%s", repo)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *repositoryService) Unstar(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/starred/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// SetArchived archives or unarchives the repository.
+func (s *repositoryService) SetArchived(ctx context.Context, repo string, archived bool) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	in := &archiveInput{Archived: archived}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *repositoryService) TriggerPipeline(context.Context, string, string, map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DispatchWorkflow(context.Context, string, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Dispatch(context.Context, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListSecrets(context.Context, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetSecret(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteSecret(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DownloadArchive(ctx context.Context, repo, ref, format string) (io.ReadCloser, *scm.Response, error) {
+	switch format {
+	case scm.ArchiveFormatTarGz, scm.ArchiveFormatZip:
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/archive/%s.%s", repo, ref, format)
+	return s.client.stream(ctx, path)
+}
+
 func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
 	panic("implement me")
 }
@@ -45,6 +157,23 @@ func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Reposit
 	return convertRepository(out), res, err
 }
 
+func (s *repositoryService) FindWithViewerState(context.Context, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Exists returns true if the repository exists and is visible
+// to the authenticated user.
+func (s *repositoryService) Exists(ctx context.Context, repo string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	res, err := s.client.do(ctx, "HEAD", path, nil, nil)
+	if err == scm.ErrNotFound {
+		return false, res, nil
+	} else if err != nil {
+		return false, res, err
+	}
+	return true, res, nil
+}
+
 func (s *repositoryService) FindHook(ctx context.Context, repo string, id string) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, id)
 	out := new(hook)
@@ -52,6 +181,9 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+// FindPerms returns the repository permissions of the
+// authenticated user, as reported by the permissions object
+// of the repository resource.
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s", repo)
 	out := new(repository)
@@ -66,6 +198,13 @@ func (s *repositoryService) List(ctx context.Context, _ scm.ListOptions) ([]*scm
 	return convertRepositoryList(out), res, err
 }
 
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	if opts.Affiliation != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	return s.List(ctx, scm.ListOptions{Page: opts.Page, Size: opts.Size})
+}
+
 func (s *repositoryService) ListHooks(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks", repo)
 	out := []*hook{}
@@ -105,6 +244,8 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+// CreateStatus creates a new commit status. Gitea stores the
+// context as text, so the label length is not validated.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo string, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/statuses/%s", repo, ref)
 	in := &statusInput{
@@ -118,6 +259,17 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo string, ref s
 	return convertStatus(out), res, err
 }
 
+func (s *repositoryService) CreateStatuses(ctx context.Context, repo, ref string, inputs []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	statuses, err := batch.CreateStatuses(ctx, inputs, func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		return s.CreateStatus(ctx, repo, ref, input)
+	})
+	return statuses, nil, err
+}
+
+func (s *repositoryService) DeleteStatus(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
@@ -130,7 +282,7 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 type (
 	// gitea repository resource.
 	repository struct {
-		ID            int       `json:"id"`
+		ID            int64     `json:"id"`
 		Owner         user      `json:"owner"`
 		Name          string    `json:"name"`
 		FullName      string    `json:"full_name"`
@@ -143,6 +295,8 @@ type (
 		CreatedAt     time.Time `json:"created_at"`
 		UpdatedAt     time.Time `json:"updated_at"`
 		Permissions   perm      `json:"permissions"`
+		Empty         bool      `json:"empty"`
+		OpenIssues    int       `json:"open_issues_count"`
 	}
 
 	// gitea permissions details.
@@ -152,9 +306,24 @@ type (
 		Pull  bool `json:"pull"`
 	}
 
+	// gitea repository create request.
+	repositoryInput struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+		Private     bool   `json:"private"`
+		AutoInit    bool   `json:"auto_init"`
+		Gitignores  string `json:"gitignores,omitempty"`
+		License     string `json:"license,omitempty"`
+	}
+
+	// gitea repository archive request.
+	archiveInput struct {
+		Archived bool `json:"archived"`
+	}
+
 	// gitea hook resource.
 	hook struct {
-		ID     int        `json:"id"`
+		ID     int64      `json:"id"`
 		Type   string     `json:"type"`
 		Events []string   `json:"events"`
 		Active bool       `json:"active"`
@@ -178,6 +347,13 @@ type (
 		Context     string    `json:"context"`
 	}
 
+	// gitea combined commit status.
+	combinedStatus struct {
+		State    string    `json:"state"`
+		Sha      string    `json:"sha"`
+		Statuses []*status `json:"statuses"`
+	}
+
 	// gitea status creation request.
 	statusInput struct {
 		State       string `json:"state"`
@@ -201,14 +377,17 @@ func convertRepositoryList(src []*repository) []*scm.Repository {
 
 func convertRepository(src *repository) *scm.Repository {
 	return &scm.Repository{
-		ID:        strconv.Itoa(src.ID),
-		Namespace: userLogin(&src.Owner),
-		Name:      src.Name,
-		Perm:      convertPerm(src.Permissions),
-		Branch:    src.DefaultBranch,
-		Private:   src.Private,
-		Clone:     src.CloneURL,
-		CloneSSH:  src.SSHURL,
+		ID:         strconv.FormatInt(src.ID, 10),
+		Namespace:  userLogin(&src.Owner),
+		Name:       src.Name,
+		Perm:       convertPerm(src.Permissions),
+		Branch:     src.DefaultBranch,
+		Private:    src.Private,
+		Clone:      src.CloneURL,
+		CloneSSH:   src.SSHURL,
+		OpenIssues: src.OpenIssues,
+		Created:    src.CreatedAt,
+		Updated:    src.UpdatedAt,
 	}
 }
 
@@ -230,10 +409,11 @@ func convertHookList(src []*hook) []*scm.Hook {
 
 func convertHook(from *hook) *scm.Hook {
 	return &scm.Hook{
-		ID:     strconv.Itoa(from.ID),
+		ID:     strconv.FormatInt(from.ID, 10),
 		Active: from.Active,
 		Target: from.Config.URL,
 		Events: from.Events,
+		Parsed: parseHookEvents(from.Events),
 	}
 }
 
@@ -258,36 +438,62 @@ func convertHookEvent(from scm.HookEvents) []string {
 	return events
 }
 
+// helper function parses the native hook events into the
+// structured hook events.
+func parseHookEvents(from []string) scm.HookEvents {
+	var events scm.HookEvents
+	for _, event := range from {
+		switch event {
+		case "pull_request":
+			events.PullRequest = true
+		case "issues":
+			events.Issue = true
+		case "issue_comment":
+			events.IssueComment = true
+		case "create", "delete":
+			events.Branch = true
+			events.Tag = true
+		case "push":
+			events.Push = true
+		}
+	}
+	return events
+}
+
+func convertCombinedStatus(from *combinedStatus) *scm.CombinedStatus {
+	return &scm.CombinedStatus{
+		State:    convertState(from.State),
+		Sha:      from.Sha,
+		Statuses: convertStatusList(from.Statuses),
+	}
+}
+
 func convertStatusList(src []*status) []*scm.Status {
 	var dst []*scm.Status
 	for _, v := range src {
 		dst = append(dst, convertStatus(v))
 	}
+	scm.SortStatuses(dst)
 	return dst
 }
 
 func convertStatus(from *status) *scm.Status {
 	return &scm.Status{
-		State:  convertState(from.State),
-		Label:  from.Context,
-		Desc:   from.Description,
-		Target: from.TargetURL,
+		State:   convertState(from.State),
+		Label:   from.Context,
+		Desc:    from.Description,
+		Target:  from.TargetURL,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
 	}
 }
 
+// helper function to convert the commit status state. The
+// Gitea state names match the canonical state names, and
+// unrecognized states are converted to scm.StateUnknown.
 func convertState(from string) scm.State {
-	switch from {
-	case "error":
-		return scm.StateError
-	case "failure":
-		return scm.StateFailure
-	case "pending":
-		return scm.StatePending
-	case "success":
-		return scm.StateSuccess
-	default:
-		return scm.StateUnknown
-	}
+	state, _ := scm.ParseState(from)
+	return state
 }
 
 func convertFromState(from scm.State) string {
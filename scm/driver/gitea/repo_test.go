@@ -5,9 +5,11 @@
 package gitea
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -69,6 +71,33 @@ func TestRepoFindPerm(t *testing.T) {
 	}
 }
 
+func TestRepoFindPerm_Push(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_push.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.FindPerms(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Perm{
+		Pull:  true,
+		Push:  true,
+		Admin: false,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestRepoList(t *testing.T) {
 	defer gock.Off()
 
@@ -94,6 +123,42 @@ func TestRepoList(t *testing.T) {
 	}
 }
 
+func TestRepoCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/go-gitea/repos").
+		File("testdata/repo_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:         "go-gitea",
+		Name:              "gitea",
+		Description:       "Git with a cup of tea",
+		AutoInit:          true,
+		GitignoreTemplate: "Go",
+		LicenseTemplate:   "MIT",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestRepoNotFound(t *testing.T) {
 	defer gock.Off()
 
@@ -106,8 +171,55 @@ func TestRepoNotFound(t *testing.T) {
 	_, _, err := client.Repositories.FindPerms(context.Background(), "gogits/go-gogs-client")
 	if err == nil {
 		t.Errorf("Expect Not Found error")
-	} else if got, want := err.Error(), "Not Found"; got != want {
-		t.Errorf("Want error %q, got %q", want, got)
+	} else if err != scm.ErrNotFound {
+		t.Errorf("Want error %q, got %q", scm.ErrNotFound, err)
+	}
+}
+
+func TestRepoStar(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/user/starred/go-gitea/gitea").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.Star(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoUnstar(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/user/starred/go-gitea/gitea").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.Unstar(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoSetArchived(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		JSON(map[string]bool{"archived": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.SetArchived(context.Background(), "go-gitea/gitea", true)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
@@ -315,3 +427,70 @@ func TestStatusCreate(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestStatusCreate_LongLabel(t *testing.T) {
+	defer gock.Off()
+
+	// gitea stores the status context as text, so long
+	// labels are sent unchanged.
+	label := strings.Repeat("a", 1000)
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		BodyString(`"context":"` + label + `"`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/status.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Repositories.CreateStatus(context.Background(), "jcitizen/my-repo", "6dcb09b5b57875f334f61aebed695e2e4193db5e", &scm.StatusInput{
+		Label: label,
+		State: scm.StateSuccess,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect the status to be sent")
+	}
+}
+
+func TestRepositoryDownloadArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/archive/master.tar.gz").
+		Reply(200).
+		Type("application/x-gzip").
+		File("testdata/archive.tar.gz")
+
+	client, _ := New("https://try.gitea.io")
+	body, _, err := client.Repositories.DownloadArchive(context.Background(), "go-gitea/gitea", "master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer body.Close()
+
+	got, _ := ioutil.ReadAll(body)
+	want, _ := ioutil.ReadFile("testdata/archive.tar.gz")
+	if !bytes.Equal(got, want) {
+		t.Errorf("Unexpected archive contents")
+	}
+}
+
+func TestParseHookEvents(t *testing.T) {
+	tests := []scm.HookEvents{
+		{Push: true},
+		{Branch: true, Tag: true},
+		{IssueComment: true},
+		{Issue: true},
+		{PullRequest: true},
+	}
+	for i, in := range tests {
+		got := parseHookEvents(convertHookEvent(in))
+		if diff := cmp.Diff(got, in); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
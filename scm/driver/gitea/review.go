@@ -22,10 +22,42 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *reviewService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) EditComment(ctx context.Context, repo string, number, id int, body string) (*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int) ([]*scm.ReviewThread, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) UnresolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) CreateCommentReply(ctx context.Context, repo string, number, inReplyTo int, body string) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
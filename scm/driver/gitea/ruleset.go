@@ -0,0 +1,35 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type rulesetService struct {
+	client *wrapper
+}
+
+func (s *rulesetService) Find(ctx context.Context, repo string, id int) (*scm.Ruleset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *rulesetService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Ruleset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *rulesetService) Create(ctx context.Context, repo string, input *scm.RulesetInput) (*scm.Ruleset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *rulesetService) Update(ctx context.Context, repo string, id int, input *scm.RulesetInput) (*scm.Ruleset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *rulesetService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -0,0 +1,19 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Repositories(ctx context.Context, query string, opts scm.ListOptions) (*scm.RepositorySearchResult, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -33,12 +33,23 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+func (s *userService) AcceptInvitation(context.Context, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListStarred(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/starred?%s", encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 //
 // native data structures
 //
 
 type user struct {
-	ID       int    `json:"id"`
+	ID       int64  `json:"id"`
 	Login    string `json:"login"`
 	Username string `json:"username"`
 	Fullname string `json:"full_name"`
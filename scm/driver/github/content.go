@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -40,6 +41,129 @@ func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*s
 	return nil, scm.ErrNotSupported
 }
 
+// Tree returns the repository tree. GitHub truncates large
+// recursive trees, which is reported by the response
+// Truncated field.
+func (s *contentService) Tree(ctx context.Context, repo, ref string, recursive bool) ([]*scm.FileEntry, *scm.Response, error) {
+	endpoint := fmt.Sprintf("repos/%s/git/trees/%s", repo, ref)
+	if recursive {
+		endpoint += "?recursive=1"
+	}
+	out := new(tree)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if res != nil {
+		res.Truncated = out.Truncated
+	}
+	return convertTreeEntryList(out.Tree), res, err
+}
+
+// BatchUpdate commits the file actions using the git data API.
+// A blob is created for each created or updated file, and the
+// new tree and commit are created on top of the branch head
+// before the branch is fast-forwarded to the new commit. The
+// mode of an updated file without a mode is read from the tree
+// of the branch head, and falls back to a regular file if the
+// tree is too large for GitHub to list in full.
+func (s *contentService) BatchUpdate(ctx context.Context, repo string, input *scm.BatchCommitInput) (*scm.Commit, *scm.Response, error) {
+	head := new(ref)
+	path := fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, input.Branch)
+	res, err := s.client.do(ctx, "GET", path, nil, head)
+	if err != nil {
+		return nil, res, err
+	}
+
+	parent := new(gitCommit)
+	path = fmt.Sprintf("repos/%s/git/commits/%s", repo, head.Object.Sha)
+	res, err = s.client.do(ctx, "GET", path, nil, parent)
+	if err != nil {
+		return nil, res, err
+	}
+
+	treeIn := &treeInput{
+		BaseTree: parent.Tree.Sha,
+		Tree:     []*treeInputEntry{},
+	}
+	var modes map[string]string
+	for _, v := range input.Actions {
+		mode := v.Mode
+		if mode == "" && v.Action == "update" {
+			if modes == nil {
+				modes, res, err = s.treeModes(ctx, repo, parent.Tree.Sha)
+				if err != nil {
+					return nil, res, err
+				}
+			}
+			mode = modes[v.Path]
+		}
+		if mode == "" {
+			mode = "100644"
+		}
+		entry := &treeInputEntry{
+			Path: v.Path,
+			Mode: mode,
+			Type: "blob",
+		}
+		if v.Action != "delete" {
+			blobIn := &blobInput{
+				Content:  base64.StdEncoding.EncodeToString(v.Data),
+				Encoding: "base64",
+			}
+			blobOut := new(blob)
+			path = fmt.Sprintf("repos/%s/git/blobs", repo)
+			res, err = s.client.do(ctx, "POST", path, blobIn, blobOut)
+			if err != nil {
+				return nil, res, err
+			}
+			entry.Sha = &blobOut.Sha
+		}
+		treeIn.Tree = append(treeIn.Tree, entry)
+	}
+	treeOut := new(tree)
+	path = fmt.Sprintf("repos/%s/git/trees", repo)
+	res, err = s.client.do(ctx, "POST", path, treeIn, treeOut)
+	if err != nil {
+		return nil, res, err
+	}
+
+	commitIn := &gitCommitInput{
+		Message: input.Message,
+		Tree:    treeOut.Sha,
+		Parents: []string{parent.Sha},
+	}
+	commitOut := new(gitCommit)
+	path = fmt.Sprintf("repos/%s/git/commits", repo)
+	res, err = s.client.do(ctx, "POST", path, commitIn, commitOut)
+	if err != nil {
+		return nil, res, err
+	}
+
+	refIn := &refInput{Sha: commitOut.Sha}
+	path = fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, input.Branch)
+	res, err = s.client.do(ctx, "PATCH", path, refIn, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertGitCommit(commitOut), res, nil
+}
+
+// treeModes returns the file modes of the blobs in the tree,
+// keyed by path.
+func (s *contentService) treeModes(ctx context.Context, repo, sha string) (map[string]string, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/trees/%s?recursive=1", repo, sha)
+	out := new(tree)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	modes := map[string]string{}
+	for _, v := range out.Tree {
+		if v.Type == "blob" {
+			modes[v.Path] = v.Mode
+		}
+	}
+	return modes, res, nil
+}
+
 type content struct {
 	Name    string `json:"name"`
 	Path    string `json:"path"`
@@ -62,3 +186,133 @@ type contentUpdate struct {
 		Date  time.Time `json:"date"`
 	} `json:"committer"`
 }
+
+type tree struct {
+	Sha       string       `json:"sha"`
+	Tree      []*treeEntry `json:"tree"`
+	Truncated bool         `json:"truncated"`
+}
+
+type treeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	Sha  string `json:"sha"`
+	Size int    `json:"size"`
+}
+
+type treeInput struct {
+	BaseTree string            `json:"base_tree"`
+	Tree     []*treeInputEntry `json:"tree"`
+}
+
+// treeInputEntry is a tree entry of a new tree. A nil Sha
+// deletes the path from the base tree.
+type treeInputEntry struct {
+	Path string  `json:"path"`
+	Mode string  `json:"mode"`
+	Type string  `json:"type"`
+	Sha  *string `json:"sha"`
+}
+
+type blobInput struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type blob struct {
+	Sha string `json:"sha"`
+}
+
+type gitCommitInput struct {
+	Message string   `json:"message"`
+	Tree    string   `json:"tree"`
+	Parents []string `json:"parents"`
+}
+
+type gitCommit struct {
+	Sha     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Message string `json:"message"`
+	Tree    struct {
+		Sha string `json:"sha"`
+		URL string `json:"url"`
+	} `json:"tree"`
+	Author struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"author"`
+	Committer struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"committer"`
+}
+
+type ref struct {
+	Ref    string `json:"ref"`
+	Object struct {
+		Sha string `json:"sha"`
+	} `json:"object"`
+}
+
+type refInput struct {
+	Sha   string `json:"sha"`
+	Force bool   `json:"force"`
+}
+
+func convertGitCommit(from *gitCommit) *scm.Commit {
+	return &scm.Commit{
+		Message: from.Message,
+		Sha:     from.Sha,
+		Tree: scm.CommitTree{
+			Sha:  from.Tree.Sha,
+			Link: from.Tree.URL,
+		},
+		Link: from.HTMLURL,
+		Author: scm.Signature{
+			Name:  from.Author.Name,
+			Email: from.Author.Email,
+			Date:  from.Author.Date,
+		},
+		Committer: scm.Signature{
+			Name:  from.Committer.Name,
+			Email: from.Committer.Email,
+			Date:  from.Committer.Date,
+		},
+	}
+}
+
+func convertTreeEntryList(from []*treeEntry) []*scm.FileEntry {
+	to := []*scm.FileEntry{}
+	for _, v := range from {
+		to = append(to, convertTreeEntry(v))
+	}
+	return to
+}
+
+func convertTreeEntry(from *treeEntry) *scm.FileEntry {
+	return &scm.FileEntry{
+		Name: path.Base(from.Path),
+		Path: from.Path,
+		Type: convertTreeEntryType(from.Type, from.Mode),
+		Size: from.Size,
+		Sha:  from.Sha,
+	}
+}
+
+// helper function returns the file entry type for the git
+// object type and file mode.
+func convertTreeEntryType(typ, mode string) string {
+	switch {
+	case typ == "tree":
+		return "dir"
+	case typ == "commit":
+		return "submodule"
+	case mode == "120000":
+		return "symlink"
+	default:
+		return "file"
+	}
+}
@@ -51,6 +51,158 @@ func TestContentFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestContentBatchUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/heads/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_ref.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/trees/b4eecafa9be2f2006ce1b709d6857b07069b4608").
+		MatchParam("recursive", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/blobs").
+		BodyString(`"content":"aGVsbG8="`).
+		Times(3).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_blob.json")
+
+	// the updated symlink keeps its mode, the new file uses the
+	// given mode and the file missing from the tree is regular.
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		BodyString(`"base_tree":"b4eecafa9be2f2006ce1b709d6857b07069b4608".*` +
+			`"path":"lib/latest","mode":"120000","type":"blob","sha":"3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15".*` +
+			`"path":"bin/run","mode":"100755","type":"blob","sha":"3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15".*` +
+			`"path":"config.yaml","mode":"100644","type":"blob","sha":"3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15".*` +
+			`"path":"old.yaml","mode":"100644","type":"blob","sha":null`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		BodyString(`"tree":"9fb037999f264ba9a7fc6274d15fa3ae2ab98312","parents":\["7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"\]`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit_create.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/master").
+		BodyString(`"sha":"7638417db6d59f3c431d3e1f261cc637155684cd"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_ref.json")
+
+	input := &scm.BatchCommitInput{
+		Branch:  "master",
+		Message: "update configuration",
+		Actions: []*scm.FileAction{
+			{Action: "update", Path: "lib/latest", Data: []byte("hello")},
+			{Action: "create", Path: "bin/run", Data: []byte("hello"), Mode: "100755"},
+			{Action: "update", Path: "config.yaml", Data: []byte("hello")},
+			{Action: "delete", Path: "old.yaml"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Contents.BatchUpdate(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/git_commit_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be sent")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestContentFind_EmptyRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/contents/README").
+		MatchParam("ref", "master").
+		Reply(409).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/content_empty.json")
+
+	client := NewDefault()
+	_, _, err := client.Contents.Find(context.Background(), "octocat/hello-world", "README", "master")
+	if err != scm.ErrEmptyRepository {
+		t.Errorf("Expect Empty Repository error, got %v", err)
+	}
+}
+
+func TestContentTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/trees/master").
+		MatchParam("recursive", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	client := NewDefault()
+	got, res, err := client.Contents.Tree(context.Background(), "octocat/hello-world", "master", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileEntry{}
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !res.Truncated {
+		t.Errorf("Expect truncated tree")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestContentCreate(t *testing.T) {
 	content := new(contentService)
 	_, err := content.Create(context.Background(), "octocat/hello-world", "README", nil)
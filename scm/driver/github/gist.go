@@ -0,0 +1,87 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type gistService struct {
+	client *wrapper
+}
+
+func (s *gistService) Find(ctx context.Context, id string) (*scm.Gist, *scm.Response, error) {
+	path := fmt.Sprintf("gists/%s", id)
+	out := new(gist)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertGist(out), res, err
+}
+
+func (s *gistService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Gist, *scm.Response, error) {
+	path := fmt.Sprintf("gists?%s", encodeListOptions(opts))
+	out := []*gist{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGistList(out), res, err
+}
+
+func (s *gistService) Create(ctx context.Context, input *scm.GistInput) (*scm.Gist, *scm.Response, error) {
+	in := &gistInput{
+		Description: input.Description,
+		Public:      input.Public,
+		Files:       map[string]*gistFile{},
+	}
+	for name, content := range input.Files {
+		in.Files[name] = &gistFile{Content: content}
+	}
+	out := new(gist)
+	res, err := s.client.do(ctx, "POST", "gists", in, out)
+	return convertGist(out), res, err
+}
+
+func (s *gistService) Delete(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("gists/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type gist struct {
+	ID          string               `json:"id"`
+	Description string               `json:"description"`
+	Public      bool                 `json:"public"`
+	Files       map[string]*gistFile `json:"files"`
+}
+
+type gistFile struct {
+	Content string `json:"content"`
+}
+
+type gistInput struct {
+	Description string               `json:"description,omitempty"`
+	Public      bool                 `json:"public"`
+	Files       map[string]*gistFile `json:"files"`
+}
+
+func convertGistList(from []*gist) []*scm.Gist {
+	to := []*scm.Gist{}
+	for _, v := range from {
+		to = append(to, convertGist(v))
+	}
+	return to
+}
+
+func convertGist(from *gist) *scm.Gist {
+	to := &scm.Gist{
+		ID:          from.ID,
+		Description: from.Description,
+		Public:      from.Public,
+		Files:       map[string]string{},
+	}
+	for name, file := range from.Files {
+		to.Files[name] = file.Content
+	}
+	return to
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/diff"
 )
 
 type gitService struct {
@@ -31,6 +32,24 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+// FindDiff returns the raw unified diff of the commit using the
+// diff media type.
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	req := &scm.Request{
+		Method: http.MethodGet,
+		Path:   fmt.Sprintf("repos/%s/commits/%s", repo, ref),
+		Header: map[string][]string{
+			"Accept": {"application/vnd.github.diff"},
+		},
+	}
+	body, res, err := s.client.stream(ctx, req)
+	if err != nil {
+		return nil, res, err
+	}
+	out, err := diff.Read(body)
+	return out, res, err
+}
+
 // FindRef returns the SHA of the given ref, such as "heads/master".
 //
 // See https://developer.github.com/v3/git/refs/#get-a-reference
@@ -52,6 +71,29 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	return res, err
 }
 
+// IsAncestor compares the ancestor against the descendant. The
+// ancestor is behind or identical to the descendant when all of its
+// commits are contained in the descendant.
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/compare/%s...%s", repo, descendant, ancestor)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return out.Status == "behind" || out.Status == "identical", res, nil
+}
+
+// CherryPick is not supported. The GitHub API does not provide
+// cherry-pick or revert endpoints for commits.
+func (s *gitService) CherryPick(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Revert(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -90,6 +132,10 @@ type branch struct {
 	Protected bool   `json:"protected"`
 }
 
+type compare struct {
+	Status string `json:"status"`
+}
+
 type commit struct {
 	Sha    string `json:"sha"`
 	URL    string `json:"html_url"`
@@ -165,9 +211,10 @@ func convertBranchList(from []*branch) []*scm.Reference {
 
 func convertBranch(from *branch) *scm.Reference {
 	return &scm.Reference{
-		Name: scm.TrimRef(from.Name),
-		Path: scm.ExpandRef(from.Name, "refs/heads/"),
-		Sha:  from.Commit.Sha,
+		Name:      scm.TrimRef(from.Name),
+		Path:      scm.ExpandRef(from.Name, "refs/heads/"),
+		Sha:       from.Commit.Sha,
+		Protected: from.Protected,
 	}
 }
 
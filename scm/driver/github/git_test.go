@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 
@@ -46,6 +47,128 @@ func TestGitFindCommit(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitFindCommit_Committer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit_committer.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.FindCommit(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	author := scm.Signature{
+		Name:   "Monalisa Octocat",
+		Email:  "mona@github.com",
+		Date:   time.Date(2011, 4, 14, 16, 0, 49, 0, time.UTC),
+		Login:  "monalisa",
+		Avatar: "https://avatars.githubusercontent.com/u/2?v=4",
+	}
+	if diff := cmp.Diff(got.Author, author); diff != "" {
+		t.Errorf("Unexpected Author")
+		t.Log(diff)
+	}
+
+	committer := scm.Signature{
+		Name:   "GitHub",
+		Email:  "noreply@github.com",
+		Date:   time.Date(2011, 4, 15, 9, 12, 3, 0, time.UTC),
+		Login:  "web-flow",
+		Avatar: "https://avatars.githubusercontent.com/u/19864447?v=4",
+	}
+	if diff := cmp.Diff(got.Committer, committer); diff != "" {
+		t.Errorf("Unexpected Committer")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		MatchHeader("Accept", `application/vnd\.github\.diff`).
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.diff")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindDiff(context.Background(), "octocat/hello-world", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/commit.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/compare/master...feature").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare_behind.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.IsAncestor(context.Background(), "octocat/hello-world", "feature", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Expect feature to be an ancestor of master")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCherryPick(t *testing.T) {
+	_, _, err := NewDefault().Git.CherryPick(context.Background(), "octocat/hello-world", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d", "master")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGitIsAncestor_Diverged(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/compare/master...feature").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare_diverged.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.IsAncestor(context.Background(), "octocat/hello-world", "feature", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got {
+		t.Errorf("Expect feature not to be an ancestor of master")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -9,35 +9,110 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"net/url"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/baseurl"
 )
 
+// Option configures the GitHub API client.
+type Option func(*wrapper)
+
+// WithPreview returns an option that enables the named API
+// preview on every request, e.g. "hellcat" for nested teams.
+// A full media type such as "application/vnd.github.hellcat-preview+json"
+// is also accepted. Requests that set their own Accept header,
+// such as raw diffs, override the client previews.
+func WithPreview(name string) Option {
+	return func(c *wrapper) {
+		c.previews = append(c.previews, previewMediaType(name))
+	}
+}
+
+// previewsKey is the context key of the request previews.
+type previewsKey struct{}
+
+// PreviewContext returns a copy of parent in which the named API
+// previews are set. Requests made with the returned context send
+// these previews instead of the client previews. Like WithPreview,
+// it accepts preview names or full media types.
+func PreviewContext(parent context.Context, names ...string) context.Context {
+	previews := make([]string, len(names))
+	for i, name := range names {
+		previews[i] = previewMediaType(name)
+	}
+	return context.WithValue(parent, previewsKey{}, previews)
+}
+
+// previewMediaType returns the media type of the named preview.
+func previewMediaType(name string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+	return "application/vnd.github." + name + "-preview+json"
+}
+
+// WithBodyHTML returns an option that requests the full media
+// type on every request, so that issue and comment bodies include
+// the rendered HTML in the BodyHTML field. The full media type is
+// used rather than the html media type so that the markdown body
+// is still returned.
+func WithBodyHTML() Option {
+	return WithPreview("application/vnd.github.full+json")
+}
+
 // New returns a new GitHub API client.
-func New(uri string) (*scm.Client, error) {
-	base, err := url.Parse(uri)
+func New(uri string, opts ...Option) (*scm.Client, error) {
+	base, err := baseurl.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(base.Path, "/") {
-		base.Path = base.Path + "/"
+	client := &wrapper{Client: new(scm.Client)}
+	for _, opt := range opts {
+		opt(client)
 	}
-	client := &wrapper{new(scm.Client)}
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGithub
 	client.Contents = &contentService{client}
+	client.Gists = &gistService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Misc = &miscService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Rulesets = &rulesetService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.SetDoFunc(client.do)
+	client.SetRateLimitFunc(client.rateLimit)
+	client.SetCapabilities(scm.Capabilities{
+		Issues:              true,
+		IssueLabels:         true,
+		IssueLocking:        true,
+		IssueTransfer:       true,
+		LinkedIssues:        true,
+		Reviews:             true,
+		ReviewThreads:       true,
+		Statuses:            true,
+		Collaborators:       true,
+		Contributors:        true,
+		Invitations:         true,
+		Teams:               true,
+		Gists:               true,
+		Stars:               true,
+		Archives:            true,
+		RepositoryTemplates: true,
+		Workflows:           true,
+		Secrets:             true,
+	})
 	return client.Client, nil
 }
 
@@ -52,6 +127,10 @@ func NewDefault() *scm.Client {
 // for making http requests and unmarshaling the response.
 type wrapper struct {
 	*scm.Client
+
+	// previews are the preview media types sent in the
+	// Accept header of every request.
+	previews []string
 }
 
 // do wraps the Client.Do function by creating the Request and
@@ -64,7 +143,49 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return c.doRequest(ctx, req, in, out)
 }
 
+// doRaw wraps the do function and returns the raw JSON encoding
+// of the response body when the client KeepRaw option is
+// enabled. The raw message is nil otherwise.
+func (c *wrapper) doRaw(ctx context.Context, method, path string, in, out interface{}) (json.RawMessage, *scm.Response, error) {
+	if !c.KeepRaw {
+		res, err := c.do(ctx, method, path, in, out)
+		return nil, res, err
+	}
+	var raw json.RawMessage
+	res, err := c.do(ctx, method, path, in, &raw)
+	if err != nil {
+		return nil, res, err
+	}
+	return raw, res, json.Unmarshal(raw, out)
+}
+
+// doRawList wraps the do function for list endpoints and returns
+// the raw JSON encoding of each list item when the client KeepRaw
+// option is enabled. The raw messages are nil otherwise.
+func (c *wrapper) doRawList(ctx context.Context, method, path string, in, out interface{}) ([]json.RawMessage, *scm.Response, error) {
+	if !c.KeepRaw {
+		res, err := c.do(ctx, method, path, in, out)
+		return nil, res, err
+	}
+	var raw []json.RawMessage
+	res, err := c.do(ctx, method, path, in, &raw)
+	if err != nil {
+		return nil, res, err
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, res, err
+	}
+	return raw, res, json.Unmarshal(data, out)
+}
+
 func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out interface{}) (*scm.Response, error) {
+	// paths are resolved relative to the base url. A leading
+	// slash would resolve against the host root and drop the
+	// subpath of GitHub Enterprise installs (e.g. /api/v3/).
+	req.Path = strings.TrimPrefix(req.Path, "/")
+	c.setPreviews(ctx, req)
+
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
@@ -84,6 +205,151 @@ func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out inter
 	}
 	defer res.Body.Close()
 
+	c.parseResponse(res)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		if res.Status == 404 {
+			err.err = scm.ErrNotFound
+			return res, err
+		}
+		if isEmptyRepository(res, err) {
+			return res, scm.ErrEmptyRepository
+		}
+		return res, checkRateLimit(res, err)
+	}
+
+	if out == nil {
+		return res, nil
+	}
+
+	// if a json response is expected, parse and return
+	// the json response.
+	return res, json.NewDecoder(res.Body).Decode(out)
+}
+
+// rateLimit returns the rate limit of the core REST API. The
+// rate limit endpoint does not count against the rate limit.
+//
+// See https://docs.github.com/en/rest/rate-limit
+func (c *wrapper) rateLimit(ctx context.Context) (*scm.Rate, *scm.Response, error) {
+	out := new(rateLimit)
+	res, err := c.do(ctx, "GET", "rate_limit", nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	rate := scm.Rate{
+		Limit:     out.Resources.Core.Limit,
+		Remaining: out.Resources.Core.Remaining,
+		Reset:     out.Resources.Core.Reset,
+	}
+	c.Client.SetRate(rate)
+	return &rate, res, nil
+}
+
+type rateLimit struct {
+	Resources struct {
+		Core struct {
+			Limit     int   `json:"limit"`
+			Remaining int   `json:"remaining"`
+			Reset     int64 `json:"reset"`
+		} `json:"core"`
+	} `json:"resources"`
+}
+
+// graphql sends a GraphQL query to the GitHub v4 API and
+// unmarshals the data field of the response into out.
+func (c *wrapper) graphql(ctx context.Context, query string, vars map[string]interface{}, out interface{}) (*scm.Response, error) {
+	in := &graphqlRequest{
+		Query:     query,
+		Variables: vars,
+	}
+	wrapped := &graphqlResponse{Data: out}
+	res, err := c.do(ctx, "POST", c.graphqlPath(), in, wrapped)
+	if err != nil {
+		return res, err
+	}
+	if len(wrapped.Errors) != 0 {
+		return res, &Error{Message: wrapped.Errors[0].Message}
+	}
+	return res, nil
+}
+
+// graphqlPath returns the path of the GraphQL endpoint
+// relative to the base URL. GitHub Enterprise serves the
+// v4 API at /api/graphql rather than /api/v3/graphql.
+func (c *wrapper) graphqlPath() string {
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// stream executes the request and returns the response body
+// without buffering it. The caller is responsible for closing
+// the returned body.
+func (c *wrapper) stream(ctx context.Context, req *scm.Request) (io.ReadCloser, *scm.Response, error) {
+	req.Path = strings.TrimPrefix(req.Path, "/")
+	req.Stream = true
+	c.setPreviews(ctx, req)
+
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.parseResponse(res)
+
+	if res.Status > 300 {
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		res.Body.Close()
+		if res.Status == 404 {
+			err.err = scm.ErrNotFound
+			return nil, res, err
+		}
+		return nil, res, checkRateLimit(res, err)
+	}
+	return res.Body, res, nil
+}
+
+// setPreviews sets the Accept header to the preview media types of
+// the context or else of the client, unless the request already
+// sets its own Accept header.
+func (c *wrapper) setPreviews(ctx context.Context, req *scm.Request) {
+	previews := c.previews
+	if v, ok := ctx.Value(previewsKey{}).([]string); ok {
+		previews = v
+	}
+	if len(previews) == 0 {
+		return
+	}
+	if req.Header == nil {
+		req.Header = map[string][]string{}
+	}
+	if len(req.Header["Accept"]) != 0 {
+		return
+	}
+	req.Header["Accept"] = []string{strings.Join(previews, ", ")}
+}
+
+// parseResponse parses the request id and rate limit details
+// from the response headers.
+func (c *wrapper) parseResponse(res *scm.Response) {
 	// parse the github request id.
 	res.ID = res.Header.Get("X-GitHub-Request-Id")
 
@@ -100,29 +366,57 @@ func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out inter
 
 	// snapshot the request rate limit
 	c.Client.SetRate(res.Rate)
+}
 
-	// if an error is encountered, unmarshal and return the
-	// error response.
-	if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+// checkRateLimit returns a *scm.RateLimitError if the error
+// response is a secondary (abuse) rate limit, which GitHub
+// reports as a 403 with a Retry-After header or a secondary
+// rate limit message. Otherwise the error is returned as is.
+//
+// See https://docs.github.com/en/rest/overview/resources-in-the-rest-api#secondary-rate-limits
+func checkRateLimit(res *scm.Response, err *Error) error {
+	if res.Status != 403 {
+		return err
 	}
-
-	if out == nil {
-		return res, nil
+	retry := res.Header.Get("Retry-After")
+	message := strings.ToLower(err.Message)
+	if retry == "" &&
+		!strings.Contains(message, "secondary rate limit") &&
+		!strings.Contains(message, "abuse detection") {
+		return err
 	}
+	seconds, _ := strconv.Atoi(retry)
+	return &scm.RateLimitError{
+		Message:    err.Message,
+		RetryAfter: time.Duration(seconds) * time.Second,
+	}
+}
 
-	// if a json response is expected, parse and return
-	// the json response.
-	return res, json.NewDecoder(res.Body).Decode(out)
+// isEmptyRepository returns true if the error response reports
+// that the repository has no commits. GitHub responds with 409
+// Conflict to content and tree requests for empty repositories.
+func isEmptyRepository(res *scm.Response, err *Error) bool {
+	return res.Status == 409 &&
+		strings.Contains(strings.ToLower(err.Message), "repository is empty")
 }
 
-// Error represents a Github error.
+// Error represents a Github error. A 404 error wraps
+// scm.ErrNotFound, so that it can be matched with errors.Is
+// while keeping the message reported by GitHub.
 type Error struct {
 	Message string `json:"message"`
+
+	err error
 }
 
 func (e *Error) Error() string {
+	if e.Message == "" && e.err != nil {
+		return e.err.Error()
+	}
 	return e.Message
 }
+
+// Unwrap returns the sentinel error wrapped by the error.
+func (e *Error) Unwrap() error {
+	return e.err
+}
@@ -5,9 +5,17 @@
 package github
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 var mockHeaders = map[string]string{
@@ -44,6 +52,83 @@ func TestClient_Base(t *testing.T) {
 	}
 }
 
+func TestClient_Subpath(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.com").
+		Get("/github/api/v3/repos/octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://example.com").
+		Get("/github/api/v3/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	client, err := New("https://example.com/github/api/v3")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world"); err != nil {
+		t.Error(err)
+	}
+	if _, _, err := client.Users.Find(context.Background()); err != nil {
+		t.Error(err)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestClient_SubpathAbsolute(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.com").
+		Get("/github/api/v3/repos/octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client, _ := New("https://example.com/github/api/v3/")
+	wrapped := &wrapper{Client: client}
+	out := new(repository)
+	if _, err := wrapped.do(context.Background(), "GET", "/repos/octocat/hello-world", nil, out); err != nil {
+		t.Error(err)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestClient_SubpathGraphQL(t *testing.T) {
+	tests := []struct {
+		base string
+		path string
+	}{
+		{"https://api.github.com", "https://api.github.com/graphql"},
+		{"https://example.com/api/v3", "https://example.com/api/graphql"},
+		{"https://example.com/github/api/v3/", "https://example.com/github/api/graphql"},
+	}
+	for _, test := range tests {
+		client, _ := New(test.base)
+		wrapped := &wrapper{Client: client}
+		uri, err := client.BaseURL.Parse(wrapped.graphqlPath())
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got, want := uri.String(), test.path; got != want {
+			t.Errorf("Want GraphQL URL %q, got %q", want, got)
+		}
+	}
+}
+
 func TestClient_Default(t *testing.T) {
 	client := NewDefault()
 	if got, want := client.BaseURL.String(), "https://api.github.com/"; got != want {
@@ -51,6 +136,95 @@ func TestClient_Default(t *testing.T) {
 	}
 }
 
+func TestClient_Capabilities(t *testing.T) {
+	want := scm.Capabilities{
+		Issues:              true,
+		IssueLabels:         true,
+		IssueLocking:        true,
+		IssueTransfer:       true,
+		LinkedIssues:        true,
+		Reviews:             true,
+		ReviewThreads:       true,
+		Statuses:            true,
+		Collaborators:       true,
+		Contributors:        true,
+		Invitations:         true,
+		Teams:               true,
+		Gists:               true,
+		Stars:               true,
+		Archives:            true,
+		RepositoryTemplates: true,
+		Workflows:           true,
+		Secrets:             true,
+	}
+	if diff := cmp.Diff(NewDefault().Capabilities(), want); diff != "" {
+		t.Errorf("Unexpected Capabilities")
+		t.Log(diff)
+	}
+}
+
+func TestClient_Preview(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		MatchHeader("Accept", `^application/vnd\.github\.mercy-preview\+json, application/vnd\.github\.hellcat-preview\+json$`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client, _ := New("https://api.github.com", WithPreview("mercy"), WithPreview("application/vnd.github.hellcat-preview+json"))
+	_, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClient_PreviewOverride(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		MatchHeader("Accept", `^application/vnd\.github\.diff$`).
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.diff")
+
+	client, _ := New("https://api.github.com", WithPreview("mercy"))
+	_, _, err := client.PullRequests.FindDiff(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClient_PreviewContext(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		MatchHeader("Accept", `^application/vnd\.github\.nebula-preview\+json$`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client, _ := New("https://api.github.com", WithPreview("mercy"))
+	ctx := PreviewContext(context.Background(), "nebula")
+	_, _, err := client.Repositories.Find(ctx, "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClient_NoScheme(t *testing.T) {
+	_, err := New("api.github.com")
+	if err == nil {
+		t.Errorf("Expect error when URL has no scheme")
+	}
+}
+
 func TestClient_Error(t *testing.T) {
 	_, err := New("http://a b.com/")
 	if err == nil {
@@ -58,6 +232,146 @@ func TestClient_Error(t *testing.T) {
 	}
 }
 
+func TestClient_SecondaryRateLimit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		Reply(403).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Retry-After", "60").
+		File("testdata/error_abuse.json")
+
+	client := NewDefault()
+	_, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
+	if !errors.Is(err, scm.ErrRateLimit) {
+		t.Errorf("Expect rate limit error, got %v", err)
+		return
+	}
+	rateErr, ok := err.(*scm.RateLimitError)
+	if !ok {
+		t.Errorf("Expect *scm.RateLimitError, got %T", err)
+		return
+	}
+	if got, want := rateErr.RetryAfter, time.Minute; got != want {
+		t.Errorf("Want retry after %s, got %s", want, got)
+	}
+}
+
+func TestClient_Forbidden(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		Reply(403).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error_forbidden.json")
+
+	client := NewDefault()
+	_, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
+	if err == nil || errors.Is(err, scm.ErrRateLimit) {
+		t.Errorf("Expect a non rate limit error, got %v", err)
+	}
+}
+
+func TestClient_MaxResponseBytes(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	client.MaxResponseBytes = 64
+	_, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
+	if err != scm.ErrResponseTooLarge {
+		t.Errorf("Expect response too large error, got %v", err)
+	}
+}
+
+func TestClient_MaxResponseBytes_Stream(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/tarball/master").
+		Reply(200).
+		Type("application/x-gzip").
+		SetHeaders(mockHeaders).
+		File("testdata/archive.tar.gz")
+
+	client := NewDefault()
+	client.MaxResponseBytes = 64
+	body, _, err := client.Repositories.DownloadArchive(context.Background(), "octocat/hello-world", "master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer body.Close()
+
+	got, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Errorf("Expect streamed body is not limited, got %v", err)
+	}
+	want, _ := ioutil.ReadFile("testdata/archive.tar.gz")
+	if len(want) <= 64 {
+		t.Fatalf("Expect archive fixture larger than the limit")
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Unexpected archive contents")
+	}
+}
+
+func TestClient_DoJSON(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/topics").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"names":["octocat","atom","electron","api"]}`)
+
+	out := struct {
+		Names []string `json:"names"`
+	}{}
+	client := NewDefault()
+	res, err := client.DoJSON(context.Background(), "GET", "repos/octocat/hello-world/topics", nil, &out)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if diff := cmp.Diff(out.Names, []string{"octocat", "atom", "electron", "api"}); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestClient_DoJSON_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/topics").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error.json")
+
+	client := NewDefault()
+	_, err := client.DoJSON(context.Background(), "GET", "repos/octocat/hello-world/topics", nil, nil)
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
 func testRate(res *scm.Response) func(t *testing.T) {
 	return func(t *testing.T) {
 		if got, want := res.Rate.Limit, 60; got != want {
@@ -96,3 +410,33 @@ func testRequest(res *scm.Response) func(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_RateLimit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/rate_limit").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/rate_limit.json")
+
+	client := NewDefault()
+	got, res, err := client.RateLimit(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Rate{Limit: 5000, Remaining: 4999, Reset: 1691591363}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if diff := cmp.Diff(client.Rate(), *want); diff != "" {
+		t.Errorf("Unexpected Rate snapshot")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
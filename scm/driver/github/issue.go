@@ -6,8 +6,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -73,11 +75,29 @@ func (s *issueService) UnassignIssue(ctx context.Context, repo string, number in
 	return res, nil
 }
 
+// SetAssignees replaces the issue assignees with a single edit
+// of the issue, returning an error if any login is missing, or
+// remains assigned, after making the call.
+//
+// See https://docs.github.com/en/rest/issues/issues#update-an-issue
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := map[string][]string{"assignees": append([]string{}, logins...)}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertIssue(out), res, checkAssignees(logins, out.Assignees)
+}
+
 func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
 	out := new(issue)
-	res, err := s.client.do(ctx, "GET", path, nil, out)
-	return convertIssue(out), res, err
+	raw, res, err := s.client.doRaw(ctx, "GET", path, nil, out)
+	to := convertIssue(out)
+	to.Raw = raw
+	return to, res, err
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
@@ -90,8 +110,12 @@ func (s *issueService) FindComment(ctx context.Context, repo string, index, id i
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues?%s", repo, encodeIssueListOptions(opts))
 	out := []*issue{}
-	res, err := s.client.do(ctx, "GET", path, nil, &out)
-	return convertIssueList(out), res, err
+	raw, res, err := s.client.doRawList(ctx, "GET", path, nil, &out)
+	to := convertIssueList(out)
+	for i := range raw {
+		to[i].Raw = raw[i]
+	}
+	return to, res, err
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
@@ -101,11 +125,62 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 	return convertIssueCommentList(out), res, err
 }
 
+// ListAllComments returns the conversation comments and the
+// inline review comments, listing all pages of both. Issues have
+// no review comments, so a not found error listing the review
+// comments is ignored.
+func (s *issueService) ListAllComments(ctx context.Context, repo string, number int) ([]*scm.Comment, *scm.Response, error) {
+	all := []*scm.Comment{}
+	opts := scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		comments, res, err := s.ListComments(ctx, repo, number, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, comments...)
+		if res.Page.Next == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			scm.SortComments(all)
+			return all, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+
+	reviews := &reviewService{s.client}
+	opts = scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		comments, res, err := reviews.ListComments(ctx, repo, number, opts)
+		if errors.Is(err, scm.ErrNotFound) {
+			scm.SortComments(all)
+			return all, res, nil
+		} else if err != nil {
+			return nil, res, err
+		}
+		for _, v := range comments {
+			all = append(all, convertReviewComment(v))
+		}
+		if res.Page.Next == 0 {
+			scm.SortComments(all)
+			return all, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			scm.SortComments(all)
+			return all, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: input.Milestone,
+		Assignees: input.Assignees,
+		Labels:    input.Labels,
 	}
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -119,7 +194,34 @@ func (s *issueService) ListLabels(ctx context.Context, repo string, number int,
 	return convertLabelObjects(out), res, err
 }
 
+// ListEvents returns the issue timeline, which includes the
+// cross-referenced, committed and renamed events that are not
+// reported by the issue events API. It falls back to the issue
+// events API when the timeline is not available, as is the case
+// on older GitHub Enterprise installations.
+//
+// See https://developer.github.com/v3/issues/timeline/
 func (s *issueService) ListEvents(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ListedIssueEvent, *scm.Response, error) {
+	req := &scm.Request{
+		Method: http.MethodGet,
+		Path:   fmt.Sprintf("repos/%s/issues/%d/timeline?%s", repo, number, encodeListOptions(opts)),
+		Header: map[string][]string{
+			// This accept header enables the timeline preview.
+			// https://developer.github.com/changes/2016-05-23-timeline-preview-api/
+			"Accept": {"application/vnd.github.mockingbird-preview+json"},
+		},
+	}
+	out := []*listedIssueEvent{}
+	res, err := s.client.doRequest(ctx, req, nil, &out)
+	if errors.Is(err, scm.ErrNotFound) || (res != nil && res.Status == http.StatusUnsupportedMediaType) {
+		return s.listIssueEvents(ctx, repo, number, opts)
+	}
+	return convertListedIssueEvents(out), res, err
+}
+
+// listIssueEvents returns the issue events from the basic
+// issue events API.
+func (s *issueService) listIssueEvents(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ListedIssueEvent, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/events?%s", repo, number, encodeListOptions(opts))
 	out := []*listedIssueEvent{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
@@ -161,10 +263,37 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, &data, out)
+	return res, err
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return s.LockWithOptions(ctx, repo, number, scm.LockOptions{})
+}
+
+// LockWithOptions locks an issue discussion, optionally with a lock reason.
+//
+// See https://developer.github.com/v3/issues/#lock-an-issue
+func (s *issueService) LockWithOptions(ctx context.Context, repo string, number int, opts scm.LockOptions) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/lock", repo, number)
-	res, err := s.client.do(ctx, "PUT", path, nil, nil)
-	return res, err
+	if opts.Reason == "" {
+		return s.client.do(ctx, "PUT", path, nil, nil)
+	}
+	req := &scm.Request{
+		Method: http.MethodPut,
+		Path:   path,
+		Header: map[string][]string{
+			// This accept header enables the lock reason preview.
+			// https://developer.github.com/changes/2018-01-10-lock-reason-api-preview/
+			"Accept": {"application/vnd.github.sailor-v-preview+json"},
+		},
+	}
+	in := &lockInput{LockReason: opts.Reason}
+	return s.client.doRequest(ctx, req, in, nil)
 }
 
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -173,14 +302,45 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 	return res, err
 }
 
+// Transfer moves an issue to another repository owned by the
+// same user or organization. The REST API has no equivalent,
+// so this uses the GraphQL transferIssue mutation.
+//
+// See https://developer.github.com/v4/mutation/transferissue/
+func (s *issueService) Transfer(ctx context.Context, repo string, number int, targetRepo string) (*scm.Issue, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	targetOwner, targetName := scm.Split(targetRepo)
+	ids := new(transferIDs)
+	res, err := s.client.graphql(ctx, transferIDsQuery, map[string]interface{}{
+		"owner":       owner,
+		"name":        name,
+		"number":      number,
+		"targetOwner": targetOwner,
+		"targetName":  targetName,
+	}, ids)
+	if err != nil {
+		return nil, res, err
+	}
+	out := new(transferIssueResult)
+	res, err = s.client.graphql(ctx, transferIssueMutation, map[string]interface{}{
+		"issueId":      ids.Repository.Issue.ID,
+		"repositoryId": ids.Target.ID,
+	}, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertGraphqlIssue(&out.TransferIssue.Issue), res, nil
+}
+
 type issue struct {
-	ID      int    `json:"id"`
-	HTMLURL string `json:"html_url"`
-	Number  int    `json:"number"`
-	State   string `json:"state"`
-	Title   string `json:"title"`
-	Body    string `json:"body"`
-	User    struct {
+	ID       int    `json:"id"`
+	HTMLURL  string `json:"html_url"`
+	Number   int    `json:"number"`
+	State    string `json:"state"`
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	BodyHTML string `json:"body_html"`
+	User     struct {
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
 	} `json:"user"`
@@ -189,16 +349,28 @@ type issue struct {
 	} `json:"labels"`
 	Assignees []user    `json:"assignees"`
 	Locked    bool      `json:"locked"`
+	Draft     bool      `json:"draft"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
+	// RepositoryURL is the api url of the repository, which
+	// is included in the search results.
+	RepositoryURL string `json:"repository_url"`
+
 	// This will be non-nil if it is a pull request.
 	PullRequest *struct{} `json:"pull_request,omitempty"`
 }
 
 type issueInput struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Milestone int      `json:"milestone,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+}
+
+type lockInput struct {
+	LockReason string `json:"lock_reason"`
 }
 
 type issueComment struct {
@@ -210,6 +382,7 @@ type issueComment struct {
 		AvatarURL string `json:"avatar_url"`
 	} `json:"user"`
 	Body      string    `json:"body"`
+	BodyHTML  string    `json:"body_html"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -225,6 +398,86 @@ type listedIssueEvent struct {
 	Actor   user      `json:"actor"`
 	Label   label     `json:"label"`
 	Created time.Time `json:"created_at"`
+
+	// timeline fields, set for cross-referenced, committed
+	// and renamed events respectively.
+	Source *struct {
+		Type  string `json:"type"`
+		Issue *issue `json:"issue"`
+	} `json:"source"`
+	Sha    string `json:"sha"`
+	Author *struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"author"`
+	Rename *struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"rename"`
+}
+
+const transferIDsQuery = `query($owner: String!, $name: String!, $number: Int!, $targetOwner: String!, $targetName: String!) {
+  repository(owner: $owner, name: $name) {
+    issue(number: $number) {
+      id
+    }
+  }
+  target: repository(owner: $targetOwner, name: $targetName) {
+    id
+  }
+}`
+
+const transferIssueMutation = `mutation($issueId: ID!, $repositoryId: ID!) {
+  transferIssue(input: {issueId: $issueId, repositoryId: $repositoryId}) {
+    issue {
+      number
+      title
+      body
+      url
+      state
+      locked
+      createdAt
+      updatedAt
+      author {
+        login
+        avatarUrl
+      }
+    }
+  }
+}`
+
+type transferIDs struct {
+	Repository struct {
+		Issue struct {
+			ID string `json:"id"`
+		} `json:"issue"`
+	} `json:"repository"`
+	Target struct {
+		ID string `json:"id"`
+	} `json:"target"`
+}
+
+type transferIssueResult struct {
+	TransferIssue struct {
+		Issue graphqlIssue `json:"issue"`
+	} `json:"transferIssue"`
+}
+
+// graphqlIssue represents an issue returned by the GraphQL API.
+type graphqlIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	URL    string `json:"url"`
+	State  string `json:"state"`
+	Locked bool   `json:"locked"`
+	Author struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatarUrl"`
+	} `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // helper function to convert from the gogs issue list to
@@ -257,6 +510,28 @@ func convertIssue(from *issue) *scm.Issue {
 		PullRequest: from.PullRequest != nil,
 		Created:     from.CreatedAt,
 		Updated:     from.UpdatedAt,
+		BodyHTML:    from.BodyHTML,
+	}
+}
+
+// helper function to convert from the graphql issue structure
+// to the common issue structure.
+func convertGraphqlIssue(from *graphqlIssue) *scm.Issue {
+	state := strings.ToLower(from.State)
+	return &scm.Issue{
+		Number: from.Number,
+		Title:  from.Title,
+		Body:   from.Body,
+		Link:   from.URL,
+		Locked: from.Locked,
+		State:  state,
+		Closed: state == "closed",
+		Author: scm.User{
+			Login:  from.Author.Login,
+			Avatar: from.Author.AvatarURL,
+		},
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
 	}
 }
 
@@ -280,9 +555,10 @@ func convertIssueComment(from *issueComment) *scm.Comment {
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
 		},
-		Link:    from.HTMLURL,
-		Created: from.CreatedAt,
-		Updated: from.UpdatedAt,
+		Link:     from.HTMLURL,
+		Created:  from.CreatedAt,
+		Updated:  from.UpdatedAt,
+		BodyHTML: from.BodyHTML,
 	}
 }
 
@@ -316,10 +592,60 @@ func convertListedIssueEvents(src []*listedIssueEvent) []*scm.ListedIssueEvent {
 }
 
 func convertListedIssueEvent(from *listedIssueEvent) *scm.ListedIssueEvent {
-	return &scm.ListedIssueEvent{
+	to := &scm.ListedIssueEvent{
 		Event:   from.Event,
 		Actor:   *convertUser(&from.Actor),
 		Label:   convertLabel(from.Label),
 		Created: from.Created,
+		Sha:     from.Sha,
+	}
+	if from.Source != nil && from.Source.Issue != nil {
+		to.Source = convertIssue(from.Source.Issue)
+	}
+	// committed events have no actor and are attributed
+	// to the commit author instead.
+	if from.Author != nil && from.Actor.Login == "" {
+		to.Actor.Name = from.Author.Name
+		to.Actor.Email = from.Author.Email
+		to.Created = from.Author.Date
+	}
+	if from.Rename != nil {
+		to.Rename = &scm.IssueRename{
+			From: from.Rename.From,
+			To:   from.Rename.To,
+		}
+	}
+	return to
+}
+
+// helper function compares the desired assignees with the
+// assignees of the updated issue, returning a scm.MissingUsers
+// error for the logins that could not be assigned, or else a
+// scm.ExtraUsers error for the users that remain assigned.
+func checkAssignees(logins []string, assignees []user) error {
+	desired := make(map[string]bool)
+	for _, login := range logins {
+		desired[NormLogin(login)] = true
+	}
+	assigned := make(map[string]bool)
+	extra := scm.ExtraUsers{Action: "unassign"}
+	for _, assignee := range assignees {
+		assigned[NormLogin(assignee.Login)] = true
+		if !desired[NormLogin(assignee.Login)] {
+			extra.Users = append(extra.Users, assignee.Login)
+		}
+	}
+	missing := scm.MissingUsers{Action: "assign"}
+	for _, login := range logins {
+		if !assigned[NormLogin(login)] {
+			missing.Users = append(missing.Users, login)
+		}
+	}
+	if len(missing.Users) > 0 {
+		return missing
+	}
+	if len(extra.Users) > 0 {
+		return extra
 	}
+	return nil
 }
@@ -7,8 +7,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 
@@ -46,6 +48,34 @@ func TestIssueFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueFind_BodyHTML(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1").
+		MatchHeader("Accept", "application/vnd.github.full\\+json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_html.json")
+
+	client, _ := New("https://api.github.com", WithBodyHTML())
+	got, _, err := client.Issues.Find(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue_html.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestIssueCommentFind(t *testing.T) {
 	defer gock.Off()
 
@@ -76,6 +106,34 @@ func TestIssueCommentFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueCommentFind_BodyHTML(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/comments/1").
+		MatchHeader("Accept", "application/vnd.github.full\\+json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_comment_html.json")
+
+	client, _ := New("https://api.github.com", WithBodyHTML())
+	got, _, err := client.Issues.FindComment(context.Background(), "octocat/hello-world", 2, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment_html.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestIssueList(t *testing.T) {
 	defer gock.Off()
 
@@ -111,6 +169,45 @@ func TestIssueList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestIssueList_Since(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues").
+		MatchParam("since", "2019-05-01T10:30:00Z").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issues.json")
+
+	client := NewDefault()
+	since := time.Date(2019, time.May, 1, 10, 30, 0, 0, time.UTC)
+	_, _, err := client.Issues.List(context.Background(), "octocat/hello-world", scm.IssueListOptions{Since: since})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueList_Sort(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues").
+		MatchParam("sort", "updated").
+		MatchParam("direction", "desc").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issues.json")
+
+	client := NewDefault()
+	opts := scm.IssueListOptions{Sort: "updated", Direction: "desc"}
+	_, _, err := client.Issues.List(context.Background(), "octocat/hello-world", opts)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueListComments(t *testing.T) {
 	defer gock.Off()
 
@@ -145,6 +242,117 @@ func TestIssueListComments(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestIssueListAllComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/comments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_all_comments.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/comments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_all_comments.json")
+
+	client := NewDefault()
+	got, res, err := client.Issues.ListAllComments(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/issue_all_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueListEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/timeline").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		MatchHeader("Accept", `^application/vnd\.github\.mockingbird-preview\+json$`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/issue_timeline.json")
+
+	client := NewDefault()
+	got, res, err := client.Issues.ListEvents(context.Background(), "octocat/hello-world", 1347, scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ListedIssueEvent{}
+	raw, _ := ioutil.ReadFile("testdata/issue_timeline.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestIssueListEvents_Fallback(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/timeline").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/events").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"event":"labeled","actor":{"login":"octocat"},"label":{"name":"bug"},"created_at":"2011-04-14T16:00:49Z"}]`)
+
+	client := NewDefault()
+	got, _, err := client.Issues.ListEvents(context.Background(), "octocat/hello-world", 1347, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if len(got) != 1 {
+		t.Errorf("Want 1 event, got %d", len(got))
+		return
+	}
+	if got, want := got[0].Event, "labeled"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
 func TestIssueCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -180,6 +388,132 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string][]string{"assignees": {"hubot"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_assignees.json")
+
+	client := NewDefault()
+	before, _, err := client.Issues.Find(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if diff := cmp.Diff(issueAssignees(before), []string{"octocat"}); diff != "" {
+		t.Errorf("Unexpected previous assignees")
+		t.Log(diff)
+	}
+
+	// octocat is removed and hubot is added in the same call.
+	got, res, err := client.Issues.SetAssignees(context.Background(), "octocat/hello-world", 1, []string{"hubot"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if diff := cmp.Diff(issueAssignees(got), []string{"hubot"}); diff != "" {
+		t.Errorf("Unexpected assignees")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueSetAssignees_Missing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string][]string{"assignees": {"hubot", "ghost"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_assignees.json")
+
+	client := NewDefault()
+	_, _, err := client.Issues.SetAssignees(context.Background(), "octocat/hello-world", 1, []string{"hubot", "ghost"})
+
+	var missing scm.MissingUsers
+	if !errors.As(err, &missing) {
+		t.Errorf("Want scm.MissingUsers error, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(missing.Users, []string{"ghost"}); diff != "" {
+		t.Errorf("Unexpected missing users")
+		t.Log(diff)
+	}
+}
+
+func TestIssueSetAssignees_Extra(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string][]string{"assignees": {}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_assignees.json")
+
+	client := NewDefault()
+	_, _, err := client.Issues.SetAssignees(context.Background(), "octocat/hello-world", 1, nil)
+
+	var extra scm.ExtraUsers
+	if !errors.As(err, &extra) {
+		t.Errorf("Want scm.ExtraUsers error, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(extra.Users, []string{"hubot"}); diff != "" {
+		t.Errorf("Unexpected extra users")
+		t.Log(diff)
+	}
+}
+
+func TestIssueCreate_Milestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues").
+		JSON(map[string]interface{}{
+			"title":     "Found a bug",
+			"body":      "I'm having a problem with this.",
+			"milestone": 1,
+			"assignees": []string{"octocat"},
+			"labels":    []string{"bug"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Found a bug",
+		Body:      "I'm having a problem with this.",
+		Milestone: 1,
+		Assignees: []string{"octocat"},
+		Labels:    []string{"bug"},
+	}
+
+	client := NewDefault()
+	_, _, err := client.Issues.Create(context.Background(), "octocat/hello-world", &input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -256,6 +590,28 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		BodyString(`{"state":"open"}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	defer gock.Off()
 
@@ -276,6 +632,28 @@ func TestIssueLock(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueLockWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/issues/1/lock").
+		MatchHeader("Accept", `application/vnd\.github\.sailor-v-preview\+json`).
+		JSON(map[string]string{"lock_reason": "resolved"}).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.LockWithOptions(context.Background(), "octocat/hello-world", 1, scm.LockOptions{Reason: scm.LockReasonResolved})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueUnlock(t *testing.T) {
 	defer gock.Off()
 
@@ -295,3 +673,72 @@ func TestIssueUnlock(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestIssueTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"number":1`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_transfer_ids.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"issueId":"MDU6SXNzdWUx","repositoryId":"MDEwOlJlcG9zaXRvcnkxMjk2MjY5"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_transfer.json")
+
+	client := NewDefault()
+	got, res, err := client.Issues.Transfer(context.Background(), "octocat/hello-world", 1, "octocat/hello-world-2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue_transfer.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueTransfer_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data":null,"errors":[{"message":"Could not resolve to a Repository"}]}`)
+
+	client := NewDefault()
+	_, _, err := client.Issues.Transfer(context.Background(), "octocat/hello-world", 1, "octocat/missing")
+	if err == nil {
+		t.Errorf("Expect error when the GraphQL API returns errors")
+		return
+	}
+	if got, want := err.Error(), "Could not resolve to a Repository"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
+
+// issueAssignees returns the logins of the issue assignees.
+func issueAssignees(issue *scm.Issue) []string {
+	logins := []string{}
+	for _, v := range issue.Assignees {
+		logins = append(logins, v.Login)
+	}
+	return logins
+}
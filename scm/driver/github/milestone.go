@@ -0,0 +1,68 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type milestoneService struct {
+	client *wrapper
+}
+
+// List returns the repository milestones.
+//
+// See https://docs.github.com/en/rest/issues/milestones#list-milestones
+func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/milestones?%s", repo, encodeMilestoneListOptions(opts))
+	out := []*milestone{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMilestoneList(out), res, err
+}
+
+type milestone struct {
+	ID           int        `json:"id"`
+	Number       int        `json:"number"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	HTMLURL      string     `json:"html_url"`
+	OpenIssues   int        `json:"open_issues"`
+	ClosedIssues int        `json:"closed_issues"`
+	DueOn        *time.Time `json:"due_on"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func convertMilestoneList(from []*milestone) []*scm.Milestone {
+	to := []*scm.Milestone{}
+	for _, v := range from {
+		to = append(to, convertMilestone(v))
+	}
+	return to
+}
+
+func convertMilestone(from *milestone) *scm.Milestone {
+	to := &scm.Milestone{
+		ID:           from.ID,
+		Number:       from.Number,
+		Title:        from.Title,
+		Description:  from.Description,
+		State:        from.State,
+		Link:         from.HTMLURL,
+		OpenIssues:   from.OpenIssues,
+		ClosedIssues: from.ClosedIssues,
+		Created:      from.CreatedAt,
+		Updated:      from.UpdatedAt,
+	}
+	if from.DueOn != nil {
+		to.DueDate = *from.DueOn
+	}
+	return to
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestMilestoneList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/milestones").
+		MatchParam("state", "open").
+		MatchParam("sort", "due_on").
+		MatchParam("direction", "asc").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/milestones.json")
+
+	opts := scm.MilestoneListOptions{Page: 1, Size: 30, State: "open", Sort: "due_on", Direction: "asc"}
+	client := NewDefault()
+	got, res, err := client.Milestones.List(context.Background(), "octocat/hello-world", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Milestone{}
+	raw, _ := ioutil.ReadFile("testdata/milestones.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	for i := 1; i < len(got); i++ {
+		if got[i].DueDate.Before(got[i-1].DueDate) {
+			t.Errorf("Want milestones ordered by due date, got %s before %s", got[i-1].Title, got[i].Title)
+		}
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
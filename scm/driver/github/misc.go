@@ -0,0 +1,68 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type miscService struct {
+	client *wrapper
+}
+
+// RenderMarkdown renders the markdown text to HTML. The text is
+// rendered as GitHub Flavored Markdown when the repository is
+// provided, and as plain markdown otherwise.
+//
+// See https://docs.github.com/en/rest/markdown/markdown#render-a-markdown-document
+func (s *miscService) RenderMarkdown(ctx context.Context, repo, text string) (string, *scm.Response, error) {
+	in := &markdownInput{
+		Text: text,
+		Mode: "markdown",
+	}
+	if repo != "" {
+		in.Mode = "gfm"
+		in.Context = repo
+	}
+	buf := new(bytes.Buffer)
+	json.NewEncoder(buf).Encode(in)
+	req := &scm.Request{
+		Method: "POST",
+		Path:   "markdown",
+		Header: map[string][]string{
+			"Accept":       {"text/html"},
+			"Content-Type": {"application/json"},
+		},
+		Body: buf,
+	}
+	body, res, err := s.client.stream(ctx, req)
+	if err != nil {
+		return "", res, err
+	}
+	defer body.Close()
+	out, err := ioutil.ReadAll(body)
+	return string(out), res, err
+}
+
+// ListEmojis returns the emojis that can be used on GitHub,
+// mapping the emoji name to the image url.
+//
+// See https://docs.github.com/en/rest/emojis/emojis#get-emojis
+func (s *miscService) ListEmojis(ctx context.Context) (map[string]string, *scm.Response, error) {
+	out := map[string]string{}
+	res, err := s.client.do(ctx, "GET", "emojis", nil, &out)
+	return out, res, err
+}
+
+type markdownInput struct {
+	Text    string `json:"text"`
+	Mode    string `json:"mode"`
+	Context string `json:"context,omitempty"`
+}
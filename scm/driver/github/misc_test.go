@@ -0,0 +1,95 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestMiscRenderMarkdown(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/markdown").
+		MatchHeader("Accept", "text/html").
+		BodyString(`{"text":"Fixes #1","mode":"gfm","context":"octocat/hello-world"}`).
+		Reply(200).
+		Type("text/html").
+		SetHeaders(mockHeaders).
+		File("testdata/markdown.html")
+
+	client := NewDefault()
+	got, res, err := client.Misc.RenderMarkdown(context.Background(), "octocat/hello-world", "Fixes #1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/markdown.html")
+	if got != string(want) {
+		t.Errorf("Want html %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestMiscRenderMarkdown_NoRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/markdown").
+		BodyString(`{"text":"Fixes #1","mode":"markdown"}`).
+		Reply(200).
+		Type("text/html").
+		SetHeaders(mockHeaders).
+		BodyString("<p>Fixes #1</p>\n")
+
+	client := NewDefault()
+	got, _, err := client.Misc.RenderMarkdown(context.Background(), "", "Fixes #1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if want := "<p>Fixes #1</p>\n"; got != want {
+		t.Errorf("Want html %q, got %q", want, got)
+	}
+}
+
+func TestMiscListEmojis(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/emojis").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/emojis.json")
+
+	client := NewDefault()
+	got, res, err := client.Misc.ListEmojis(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := map[string]string{}
+	raw, _ := ioutil.ReadFile("testdata/emojis.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
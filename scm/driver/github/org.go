@@ -6,11 +6,13 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/batch"
 )
 
 type organizationService struct {
@@ -39,14 +41,14 @@ type teamMember struct {
 func (s *organizationService) IsMember(ctx context.Context, org string, user string) (bool, *scm.Response, error) {
 	path := fmt.Sprintf("orgs/%s/members/%s", org, user)
 	res, err := s.client.do(ctx, "GET", path, nil, nil)
-	if err != nil {
+	if errors.Is(err, scm.ErrNotFound) {
+		return false, res, nil
+	} else if err != nil {
 		return false, res, err
 	}
 	code := res.Status
 	if code == 204 {
 		return true, res, nil
-	} else if code == 404 {
-		return false, res, nil
 	} else if code == 302 {
 		return false, res, fmt.Errorf("requester is not %s org member", org)
 	}
@@ -94,6 +96,75 @@ func (s *organizationService) ListTeamMembers(ctx context.Context, id int, role
 	return convertTeamMembers(out), res, err
 }
 
+// ListAllHooks returns the organization webhooks and the webhooks
+// of every organization repository, keyed by the organization name
+// and the repository full names. The repository hooks are listed
+// concurrently. Repositories whose hooks cannot be listed, such as
+// repositories the user cannot administer, are omitted and the
+// failures are joined into the returned error.
+func (s *organizationService) ListAllHooks(ctx context.Context, org string) (map[string][]*scm.Hook, *scm.Response, error) {
+	orgHooks, res, err := s.listHooks(ctx, fmt.Sprintf("orgs/%s/hooks", org))
+	if err != nil {
+		return nil, res, err
+	}
+	repos, res, err := s.listRepositories(ctx, org)
+	if err != nil {
+		return nil, res, err
+	}
+	hooks, err := batch.ListHooks(ctx, repos, func(ctx context.Context, repo string) ([]*scm.Hook, error) {
+		out, _, err := s.listHooks(ctx, fmt.Sprintf("repos/%s/hooks", repo))
+		return out, err
+	})
+	hooks[org] = orgHooks
+	return hooks, res, err
+}
+
+// listHooks returns all pages of the hooks listed at the path.
+func (s *organizationService) listHooks(ctx context.Context, path string) ([]*scm.Hook, *scm.Response, error) {
+	hooks := []*scm.Hook{}
+	opts := scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		out := []*hook{}
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s?%s", path, encodeListOptions(opts)), nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		hooks = append(hooks, convertHookList(out)...)
+		if res.Page.Next == 0 {
+			return hooks, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return hooks, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// listRepositories returns the full names of all the
+// organization repositories.
+func (s *organizationService) listRepositories(ctx context.Context, org string) ([]string, *scm.Response, error) {
+	repos := []string{}
+	opts := scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		out := []*repository{}
+		path := fmt.Sprintf("orgs/%s/repos?%s", org, encodeListOptions(opts))
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			repos = append(repos, v.FullName)
+		}
+		if res.Page.Next == 0 {
+			return repos, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return repos, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
 func convertOrganizationList(from []*organization) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from {
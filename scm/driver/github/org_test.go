@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -153,3 +154,109 @@ func TestTeamMembers(t *testing.T) {
 	t.Run("Rate", testRate(res))
 	t.Run("Page", testPage(res))
 }
+
+func TestOrganizationListAllHooks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/hooks").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/org_hooks.json")
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/repos").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://api.github.com/orgs/octocat/repos?page=2>; rel="next"`).
+		File("testdata/org_repos.json")
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/repos").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/Hello-World/hooks").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hooks.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/Spoon-Knife/hooks").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	client := NewDefault()
+	got, _, err := client.Organizations.ListAllHooks(context.Background(), "octocat")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := map[string][]*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/org_all_hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListAllHooks_RepositoryError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/hooks").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/org_hooks.json")
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/repos").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/org_repos.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/Hello-World/hooks").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hooks.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/Spoon-Knife/hooks").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error.json")
+
+	client := NewDefault()
+	got, _, err := client.Organizations.ListAllHooks(context.Background(), "octocat")
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Want joined error to wrap the repository failure, got %v", err)
+	}
+	if _, ok := got["octocat/Spoon-Knife"]; ok {
+		t.Errorf("Want failed repositories to be omitted")
+	}
+	if got, want := len(got["octocat/Hello-World"]), 1; got != want {
+		t.Errorf("Want %d repository hooks, got %d", want, got)
+	}
+	if got, want := len(got["octocat"]), 1; got != want {
+		t.Errorf("Want %d organization hooks, got %d", want, got)
+	}
+}
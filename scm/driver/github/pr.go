@@ -6,10 +6,15 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/diff"
 	"github.com/jenkins-x/go-scm/scm/driver/internal/null"
 )
 
@@ -20,14 +25,129 @@ type pullService struct {
 func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
 	out := new(pr)
-	res, err := s.client.do(ctx, "GET", path, nil, out)
-	return convertPullRequest(out), res, err
+	raw, res, err := s.client.doRaw(ctx, "GET", path, nil, out)
+	to := convertPullRequest(out)
+	to.Raw = raw
+	return to, res, err
+}
+
+// FindDiff returns the raw unified diff of the pull request using
+// the diff media type.
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) ([]byte, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("repos/%s/pulls/%d", repo, number),
+		Header: map[string][]string{
+			"Accept": {"application/vnd.github.diff"},
+		},
+	}
+	body, res, err := s.client.stream(ctx, req)
+	if err != nil {
+		return nil, res, err
+	}
+	out, err := diff.Read(body)
+	return out, res, err
 }
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls?%s", repo, encodePullRequestListOptions(opts))
 	out := []*pr{}
-	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	raw, res, err := s.client.doRawList(ctx, "GET", path, nil, &out)
+	to := convertPullRequestList(out)
+	for i := range raw {
+		to[i].Raw = raw[i]
+	}
+	return to, res, err
+}
+
+// Create creates a new pull request. GitHub does not accept the
+// assignees and reviewers on create, so they are set by follow-up
+// requests once the pull request exists.
+//
+// See https://docs.github.com/en/rest/pulls/pulls#create-a-pull-request
+func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls", repo)
+	in := &prInput{
+		Title: input.Title,
+		Body:  input.Body,
+		Head:  input.Source,
+		Base:  input.Target,
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertPullRequest(out)
+
+	var errs []error
+	if len(input.Assignees) != 0 {
+		if _, err := s.AssignIssue(ctx, repo, to.Number, input.Assignees); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(input.Reviewers) != 0 {
+		if _, err := s.requestReviewers(ctx, repo, to.Number, input.Reviewers); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return to, res, errors.Join(errs...)
+}
+
+// requestReviewers requests a review from the users, returning
+// an error if any user is missing from the requested reviewers
+// after making the call.
+//
+// See https://docs.github.com/en/rest/pulls/review-requests#request-reviewers-for-a-pull-request
+func (s *pullService) requestReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := map[string][]string{"reviewers": logins}
+	out := new(pr)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return res, err
+	}
+	requested := make(map[string]bool)
+	for _, reviewer := range out.RequestedReviewers {
+		requested[NormLogin(reviewer.Login)] = true
+	}
+	missing := scm.MissingUsers{Action: "request a review from"}
+	for _, login := range logins {
+		if !requested[NormLogin(login)] {
+			missing.Users = append(missing.Users, login)
+		}
+	}
+	if len(missing.Users) > 0 {
+		return res, missing
+	}
+	return res, nil
+}
+
+// ListMine returns the open pull requests created by the
+// authenticated user, using the issue search api.
+func (s *pullService) ListMine(ctx context.Context, opts scm.ListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("q", "is:pr is:open author:@me")
+	path := fmt.Sprintf("search/issues?%s", encodeListOptionsWith(opts, params))
+	out := new(issueSearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueSearchPullRequestList(out.Items), res, err
+}
+
+// ListForCommit returns the pull requests associated with the
+// commit, which requires the groot preview.
+//
+// See https://docs.github.com/en/rest/commits/commits#list-pull-requests-associated-with-a-commit
+func (s *pullService) ListForCommit(ctx context.Context, repo, sha string) ([]*scm.PullRequest, *scm.Response, error) {
+	req := &scm.Request{
+		Method: http.MethodGet,
+		Path:   fmt.Sprintf("repos/%s/commits/%s/pulls", repo, sha),
+		Header: map[string][]string{
+			"Accept": {"application/vnd.github.groot-preview+json"},
+		},
+	}
+	out := []*pr{}
+	res, err := s.client.doRequest(ctx, req, nil, &out)
 	return convertPullRequestList(out), res, err
 }
 
@@ -51,6 +171,208 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) Rebase(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// FindCombinedStatus returns the combined status of the pull
+// request head commit. The check runs of the commit are listed
+// with the commit statuses, and are included in the combined
+// state.
+func (s *pullService) FindCombinedStatus(ctx context.Context, repo string, number int) (*scm.CombinedStatus, *scm.Response, error) {
+	pull, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	combined, res, err := s.client.Repositories.FindCombinedStatus(ctx, repo, pull.Sha)
+	if err != nil {
+		return nil, res, err
+	}
+	checks, res, err := s.listCheckRuns(ctx, repo, pull.Sha)
+	if err != nil {
+		return nil, res, err
+	}
+	combined.Statuses = append(combined.Statuses, checks...)
+	scm.SortStatuses(combined.Statuses)
+	states := []scm.State{}
+	for _, v := range combined.Statuses {
+		states = append(states, v.State)
+	}
+	combined.State = scm.CombineStates(states...)
+	return combined, res, nil
+}
+
+// listCheckRuns returns the check runs of the commit as commit
+// statuses, listing all pages. No check runs are returned if the
+// checks api is not available.
+//
+// See https://docs.github.com/en/rest/checks/runs#list-check-runs-for-a-git-reference
+func (s *pullService) listCheckRuns(ctx context.Context, repo, ref string) ([]*scm.Status, *scm.Response, error) {
+	statuses := []*scm.Status{}
+	opts := scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		path := fmt.Sprintf("repos/%s/commits/%s/check-runs?%s", repo, ref, encodeListOptions(opts))
+		out := new(checkRuns)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if errors.Is(err, scm.ErrNotFound) {
+			return statuses, res, nil
+		} else if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out.CheckRuns {
+			statuses = append(statuses, convertCheckRun(v))
+		}
+		if res.Page.Next == 0 {
+			return statuses, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return statuses, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// ListLinkedIssues returns the issues that are closed when the
+// pull request is merged. The closing issue references are only
+// exposed by the GraphQL API, which limits the result to the
+// first 100 issues.
+//
+// See https://docs.github.com/en/graphql/reference/objects#pullrequest
+func (s *pullService) ListLinkedIssues(ctx context.Context, repo string, number int) ([]*scm.Issue, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	out := new(linkedIssues)
+	res, err := s.client.graphql(ctx, linkedIssuesQuery, map[string]interface{}{
+		"owner":  owner,
+		"name":   name,
+		"number": number,
+	}, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := []*scm.Issue{}
+	for _, node := range out.Repository.PullRequest.ClosingIssuesReferences.Nodes {
+		to = append(to, convertGraphqlIssue(node))
+	}
+	return to, res, nil
+}
+
+// ApprovalCount returns the number of users whose latest review
+// approves the pull request, and the number of approving reviews
+// required by the protection of the target branch. The required
+// count is zero when the target branch is not protected, or when
+// the token cannot read the branch protection.
+//
+// See https://docs.github.com/en/rest/pulls/reviews#list-reviews-for-a-pull-request
+func (s *pullService) ApprovalCount(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	pull, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+
+	// a later review supersedes an approval only when it requests
+	// changes or the approval is dismissed; comments do not.
+	states := map[string]string{}
+	opts := scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		path := fmt.Sprintf("repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+		out := []*review{}
+		res, err = s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			switch v.State {
+			case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+				states[NormLogin(v.User.Login)] = v.State
+			}
+		}
+		if res.Page.Next == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+	to := new(scm.Approvals)
+	for _, state := range states {
+		if state == "APPROVED" {
+			to.Approved++
+		}
+	}
+
+	// the branch protection is not found when the branch is not
+	// protected, and is forbidden unless the token has admin
+	// rights. The required count is left at zero in both cases.
+	path := fmt.Sprintf("repos/%s/branches/%s/protection/required_pull_request_reviews", repo, url.PathEscape(pull.Target))
+	out := new(requiredReviews)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if _, ok := err.(*Error); ok && res.Status == 403 {
+		return to, res, nil
+	} else if errors.Is(err, scm.ErrNotFound) {
+		return to, res, nil
+	} else if err != nil {
+		return nil, res, err
+	}
+	to.Required = out.RequiredApprovingReviewCount
+	return to, res, nil
+}
+
+const linkedIssuesQuery = `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      closingIssuesReferences(first: 100) {
+        nodes {
+          number
+          title
+          body
+          url
+          state
+          locked
+          createdAt
+          updatedAt
+          author {
+            login
+            avatarUrl
+          }
+        }
+      }
+    }
+  }
+}`
+
+type linkedIssues struct {
+	Repository struct {
+		PullRequest struct {
+			ClosingIssuesReferences struct {
+				Nodes []*graphqlIssue `json:"nodes"`
+			} `json:"closingIssuesReferences"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+type requiredReviews struct {
+	RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+}
+
+type checkRuns struct {
+	TotalCount int         `json:"total_count"`
+	CheckRuns  []*checkRun `json:"check_runs"`
+}
+
+type checkRun struct {
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	HTMLURL     string    `json:"html_url"`
+	DetailsURL  string    `json:"details_url"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	Output      struct {
+		Title string `json:"title"`
+	} `json:"output"`
+}
+
 type prBranch struct {
 	Ref  string     `json:"ref"`
 	Sha  string     `json:"sha"`
@@ -58,6 +380,13 @@ type prBranch struct {
 	Repo repository `json:"repo"`
 }
 
+type prInput struct {
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
 type pr struct {
 	Number             int         `json:"number"`
 	State              string      `json:"state"`
@@ -76,6 +405,11 @@ type pr struct {
 	UpdatedAt          time.Time   `json:"updated_at"`
 }
 
+type issueSearchResult struct {
+	TotalCount int      `json:"total_count"`
+	Items      []*issue `json:"items"`
+}
+
 type file struct {
 	Sha              string `json:"sha"`
 	Filename         string `json:"filename"`
@@ -96,6 +430,58 @@ func convertPullRequestList(from []*pr) []*scm.PullRequest {
 	return to
 }
 
+// helper function to convert the pull requests of the issue
+// search results, populating the repository from the issue
+// repository url.
+func convertIssueSearchPullRequestList(from []*issue) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		to = append(to, convertIssueSearchPullRequest(v))
+	}
+	return to
+}
+
+func convertIssueSearchPullRequest(from *issue) *scm.PullRequest {
+	repo := convertRepositoryURL(from.RepositoryURL)
+	return &scm.PullRequest{
+		Number: from.Number,
+		Title:  from.Title,
+		Body:   from.Body,
+		Ref:    fmt.Sprintf("refs/pull/%d/head", from.Number),
+		Link:   from.HTMLURL,
+		State:  from.State,
+		Closed: from.State == "closed",
+		Draft:  from.Draft,
+		Base: scm.PullRequestBranch{
+			Repo: repo,
+		},
+		Author: scm.User{
+			Login:  from.User.Login,
+			Avatar: from.User.AvatarURL,
+		},
+		Assignees: convertUsers(from.Assignees),
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+	}
+}
+
+// helper function to convert the repository api url, in the
+// https://api.github.com/repos/:owner/:name format, to a
+// repository reference.
+func convertRepositoryURL(from string) scm.Repository {
+	i := strings.Index(from, "/repos/")
+	if i == -1 {
+		return scm.Repository{}
+	}
+	fullName := from[i+len("/repos/"):]
+	namespace, name := scm.Split(fullName)
+	return scm.Repository{
+		Namespace: namespace,
+		Name:      name,
+		FullName:  fullName,
+	}
+}
+
 func convertPullRequest(from *pr) *scm.PullRequest {
 	return &scm.PullRequest{
 		Number:    from.Number,
@@ -164,3 +550,42 @@ func convertChange(from *file) *scm.Change {
 		Sha:       from.Sha,
 	}
 }
+
+// helper function converts the check run to a commit status.
+func convertCheckRun(from *checkRun) *scm.Status {
+	target := from.DetailsURL
+	if target == "" {
+		target = from.HTMLURL
+	}
+	return &scm.Status{
+		State:   convertCheckRunState(from.Status, from.Conclusion),
+		Label:   from.Name,
+		Desc:    from.Output.Title,
+		Target:  target,
+		Created: from.StartedAt,
+		Updated: from.CompletedAt,
+	}
+}
+
+// helper function converts the check run status and conclusion
+// to a commit state.
+func convertCheckRunState(status, conclusion string) scm.State {
+	switch status {
+	case "queued", "waiting", "requested", "pending":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	}
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return scm.StateSuccess
+	case "failure", "timed_out", "action_required", "startup_failure":
+		return scm.StateFailure
+	case "cancelled":
+		return scm.StateCanceled
+	case "stale":
+		return scm.StatePending
+	default:
+		return scm.StateUnknown
+	}
+}
@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -81,6 +82,250 @@ func TestPullList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestPullListForCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/pulls").
+		MatchHeader("Accept", "application/vnd.github.groot-preview\\+json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pulls.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListForCommit(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/pulls.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls").
+		JSON(map[string]string{
+			"title": "new-feature",
+			"body":  "Please pull these awesome changes",
+			"head":  "new-topic",
+			"base":  "master",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1347/assignees").
+		BodyString(`"assignees":\["octocat"\]`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		BodyString(`"reviewers":\["hubot"\]`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_requested_reviewers.json")
+
+	input := &scm.PullRequestInput{
+		Title:     "new-feature",
+		Body:      "Please pull these awesome changes",
+		Source:    "new-topic",
+		Target:    "master",
+		Assignees: []string{"octocat"},
+		Reviewers: []string{"hubot"},
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullCreate_PartialFailure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_requested_reviewers.json")
+
+	input := &scm.PullRequestInput{
+		Title:     "new-feature",
+		Source:    "new-topic",
+		Target:    "master",
+		Reviewers: []string{"hubot", "octocat"},
+	}
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.Create(context.Background(), "octocat/hello-world", input)
+	if got == nil || got.Number != 1347 {
+		t.Errorf("Want the created pull request returned on partial failure")
+	}
+
+	var missing scm.MissingUsers
+	if !errors.As(err, &missing) {
+		t.Errorf("Want scm.MissingUsers error, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(missing.Users, []string{"octocat"}); diff != "" {
+		t.Errorf("Unexpected missing users")
+		t.Log(diff)
+	}
+}
+
+func TestPullListMine(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/issues").
+		MatchParam("q", "is:pr is:open author:@me").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_pulls.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListMine(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/search_pulls.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestPullList_Base(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls").
+		MatchParam("state", "open").
+		MatchParam("base", "master").
+		MatchParam("head", "octocat:new-topic").
+		MatchParam("sort", "updated").
+		MatchParam("direction", "desc").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pulls.json")
+
+	client := NewDefault()
+	opts := scm.PullRequestListOptions{
+		State:     "open",
+		Base:      "master",
+		Head:      "octocat:new-topic",
+		Sort:      "updated",
+		Direction: "desc",
+	}
+	got, _, err := client.PullRequests.List(context.Background(), "octocat/hello-world", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/pulls.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullList_Raw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		MatchParam("state", "all").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pulls.json")
+
+	client := NewDefault()
+	client.KeepRaw = true
+	got, _, err := client.PullRequests.List(context.Background(), "octocat/hello-world", scm.PullRequestListOptions{Page: 1, Size: 30, Open: true, Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) == 0 {
+		t.Errorf("Expect pull requests")
+		return
+	}
+
+	extra := struct {
+		DiffURL string `json:"diff_url"`
+	}{}
+	if err := json.Unmarshal(got[0].Raw, &extra); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := extra.DiffURL, "https://github.com/octocat/Hello-World/pull/1347.diff"; got != want {
+		t.Errorf("Want raw diff_url %q, got %q", want, got)
+	}
+}
+
 func TestPullListChanges(t *testing.T) {
 	gock.New("https://api.github.com").
 		Get("/repos/octocat/hello-world/pulls/1347/files").
@@ -133,6 +378,13 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullRebase(t *testing.T) {
+	_, err := NewDefault().PullRequests.Rebase(context.Background(), "octocat/hello-world", 1347)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -152,3 +404,218 @@ func TestPullClose(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestPullFindCombinedStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status").
+		Reply(200).
+		Type("application/json").
+		File("testdata/combined_status.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/check-runs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/check_runs.json")
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.FindCombinedStatus(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CombinedStatus)
+	raw, _ := ioutil.ReadFile("testdata/combined_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestPullListLinkedIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`closingIssuesReferences`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_linked_issues.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListLinkedIssues(context.Background(), "octocat/hello-world", 1348)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/pr_linked_issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		MatchHeader("Accept", `application/vnd\.github\.diff`).
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.diff")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.FindDiff(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/pr.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullApprovalCount(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_reviews.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection/required_pull_request_reviews").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection_reviews.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ApprovalCount(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Approvals{Approved: 2, Required: 2}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullApprovalCount_Unprotected(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviews.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection/required_pull_request_reviews").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message":"Branch not protected"}`)
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.ApprovalCount(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Approvals{Approved: 2}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullApprovalCount_Forbidden(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviews.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection/required_pull_request_reviews").
+		Reply(403).
+		Type("application/json").
+		BodyString(`{"message":"Must have admin rights to Repository."}`)
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.ApprovalCount(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Approvals{Approved: 2}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
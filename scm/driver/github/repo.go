@@ -6,18 +6,24 @@ package github
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/batch"
+	"golang.org/x/crypto/nacl/box"
 )
 
 type repository struct {
-	ID    int `json:"id"`
+	ID    int64 `json:"id"`
 	Owner struct {
-		ID        int    `json:"id"`
+		ID        int64  `json:"id"`
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
 	} `json:"owner"`
@@ -29,6 +35,7 @@ type repository struct {
 	SSHURL        string    `json:"ssh_url"`
 	CloneURL      string    `json:"clone_url"`
 	DefaultBranch string    `json:"default_branch"`
+	OpenIssues    int       `json:"open_issues_count"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	Permissions   struct {
@@ -38,8 +45,17 @@ type repository struct {
 	} `json:"permissions"`
 }
 
+type archiveInput struct {
+	Archived bool `json:"archived"`
+}
+
+type subscription struct {
+	Subscribed bool `json:"subscribed"`
+	Ignored    bool `json:"ignored"`
+}
+
 type hook struct {
-	ID     int      `json:"id,omitempty"`
+	ID     int64    `json:"id,omitempty"`
 	Name   string   `json:"name"`
 	Events []string `json:"events"`
 	Active bool     `json:"active"`
@@ -50,6 +66,85 @@ type hook struct {
 	} `json:"config"`
 }
 
+type collaborator struct {
+	user
+	Permissions struct {
+		Admin bool `json:"admin"`
+		Push  bool `json:"push"`
+		Pull  bool `json:"pull"`
+	} `json:"permissions"`
+}
+
+type contributor struct {
+	user
+	Contributions int `json:"contributions"`
+}
+
+type invitation struct {
+	ID          int        `json:"id"`
+	Repository  repository `json:"repository"`
+	Invitee     user       `json:"invitee"`
+	Inviter     user       `json:"inviter"`
+	Permissions string     `json:"permissions"`
+	HTMLURL     string     `json:"html_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type collaboratorInput struct {
+	Permission string `json:"permission,omitempty"`
+}
+
+type repositoryInput struct {
+	Name              string `json:"name"`
+	Description       string `json:"description,omitempty"`
+	Private           bool   `json:"private"`
+	AutoInit          bool   `json:"auto_init,omitempty"`
+	GitignoreTemplate string `json:"gitignore_template,omitempty"`
+	LicenseTemplate   string `json:"license_template,omitempty"`
+}
+
+type templateInput struct {
+	Owner       string `json:"owner,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     bool   `json:"private"`
+}
+
+type workflowDispatchInput struct {
+	Ref    string                 `json:"ref"`
+	Inputs map[string]interface{} `json:"inputs,omitempty"`
+}
+
+type repositoryDispatchInput struct {
+	EventType     string                 `json:"event_type"`
+	ClientPayload map[string]interface{} `json:"client_payload,omitempty"`
+}
+
+type secret struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created_at"`
+	Updated time.Time `json:"updated_at"`
+}
+
+type secretList struct {
+	TotalCount int       `json:"total_count"`
+	Secrets    []*secret `json:"secrets"`
+}
+
+type secretKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+type secretInput struct {
+	EncryptedValue string `json:"encrypted_value"`
+	KeyID          string `json:"key_id"`
+}
+
+// maxStatusLabelLength is the maximum length of a commit status
+// context. Longer contexts are truncated by GitHub.
+const maxStatusLabelLength = 255
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -74,14 +169,14 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user strin
 		},
 	}
 	res, err := s.client.doRequest(ctx, req, nil, nil)
-	if err != nil {
+	if errors.Is(err, scm.ErrNotFound) {
+		return false, res, nil
+	} else if err != nil {
 		return false, res, err
 	}
 	code := res.Status
 	if code == 204 {
 		return true, res, nil
-	} else if code == 404 {
-		return false, res, nil
 	}
 	return false, res, fmt.Errorf("unexpected status: %d", code)
 }
@@ -91,10 +186,10 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user strin
 //
 // See 'IsCollaborator' for more details.
 // See https://developer.github.com/v3/repos/collaborators/
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
 	req := &scm.Request{
 		Method: http.MethodGet,
-		Path:   fmt.Sprintf("repos/%s/collaborators", repo),
+		Path:   fmt.Sprintf("repos/%s/collaborators?%s", repo, encodeListOptions(opts)),
 		Header: map[string][]string{
 			// This accept header enables the nested teams preview.
 			// https://developer.github.com/changes/2017-08-30-preview-nested-teams/
@@ -106,12 +201,299 @@ func (s *repositoryService) ListCollaborators(ctx context.Context, repo string)
 	return convertUsers(out), res, err
 }
 
-// Find returns the repository by name.
+// ListCollaboratorsWithPermission lists the users who have access to
+// the repo, including organization members and outside collaborators,
+// with their permission level.
+//
+// See https://developer.github.com/v3/repos/collaborators/#list-collaborators
+func (s *repositoryService) ListCollaboratorsWithPermission(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/collaborators?affiliation=all&%s", repo, encodeListOptions(opts))
+	out := []*collaborator{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCollaboratorList(out), res, err
+}
+
+// ListContributors lists the contributors to the repository,
+// sorted by the number of commits in descending order.
+//
+// See https://docs.github.com/en/rest/repos/repos#list-repository-contributors
+func (s *repositoryService) ListContributors(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/contributors?%s", repo, encodeListOptions(opts))
+	out := []*contributor{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertContributorList(out), res, err
+}
+
+// AddCollaborator adds a collaborator to the repo with the given
+// permission, such as pull, push or admin. GitHub responds with
+// 201 when an invitation is created, and 204 when the user is
+// already a collaborator or an organization member.
+//
+// See https://developer.github.com/v3/repos/collaborators/#add-user-as-a-collaborator
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/collaborators/%s", repo, user)
+	in := &collaboratorInput{Permission: permission}
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	if err != nil {
+		return false, res, err
+	}
+	return res.Status == 201, res, nil
+}
+
+// RemoveCollaborator removes a collaborator from the repo.
+//
+// See https://developer.github.com/v3/repos/collaborators/#remove-user-as-a-collaborator
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, user string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/collaborators/%s", repo, user)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ListInvitations lists the pending collaborator invitations for the repo.
+//
+// See https://developer.github.com/v3/repos/invitations/#list-invitations-for-a-repository
+func (s *repositoryService) ListInvitations(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/invitations?%s", repo, encodeListOptions(opts))
+	out := []*invitation{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertInvitationList(out), res, err
+}
+
+// DeleteInvitation deletes a pending collaborator invitation.
+//
+// See https://developer.github.com/v3/repos/invitations/#delete-a-repository-invitation
+func (s *repositoryService) DeleteInvitation(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/invitations/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) UploadAttachment(context.Context, string, string, io.Reader) (*scm.Attachment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Create creates a new repository. The repository is created in
+// the organization when a namespace is given, since the user
+// endpoint only creates repositories for the authenticated user.
+//
+// See https://docs.github.com/en/rest/repos/repos#create-an-organization-repository
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	path := "user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:              input.Name,
+		Description:       input.Description,
+		Private:           input.Private,
+		AutoInit:          input.AutoInit,
+		GitignoreTemplate: input.GitignoreTemplate,
+		LicenseTemplate:   input.LicenseTemplate,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// CreateFromTemplate creates a new repository from the template repository.
+func (s *repositoryService) CreateFromTemplate(ctx context.Context, templateRepo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	req := &scm.Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("repos/%s/generate", templateRepo),
+		Header: map[string][]string{
+			// This accept header enables the repository templates preview.
+			// https://developer.github.com/changes/2019-07-16-repository-templates-api/
+			"Accept": {"application/vnd.github.baptiste-preview+json"},
+		},
+	}
+	in := &templateInput{
+		Owner:       input.Namespace,
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     input.Private,
+	}
+	out := new(repository)
+	res, err := s.client.doRequest(ctx, req, in, out)
+	return convertRepository(out), res, err
+}
+
+// Star stars the repository for the authenticated user.
+//
+// See https://developer.github.com/v3/activity/starring/#star-a-repository
+func (s *repositoryService) Star(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("user/starred/%s", repo)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// Unstar removes the star from the repository for the
+// authenticated user.
+//
+// See https://developer.github.com/v3/activity/starring/#unstar-a-repository
+func (s *repositoryService) Unstar(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("user/starred/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// SetArchived archives the repository. Unarchiving is not
+// supported, as GitHub does not allow archived repositories
+// to be unarchived through the API.
+//
+// See https://developer.github.com/v3/repos/#update-a-repository
+func (s *repositoryService) SetArchived(ctx context.Context, repo string, archived bool) (*scm.Response, error) {
+	if !archived {
+		return nil, fmt.Errorf("unarchiving repositories through the GitHub API: %w", scm.ErrNotSupported)
+	}
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &archiveInput{Archived: archived}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *repositoryService) TriggerPipeline(context.Context, string, string, map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// DispatchWorkflow triggers a workflow_dispatch event for the
+// workflow, identified by its file name or id, at the ref.
+//
+// See https://docs.github.com/en/rest/actions/workflows#create-a-workflow-dispatch-event
+func (s *repositoryService) DispatchWorkflow(ctx context.Context, repo, workflow, ref string, inputs map[string]interface{}) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/workflows/%s/dispatches", repo, workflow)
+	in := &workflowDispatchInput{
+		Ref:    ref,
+		Inputs: inputs,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+// Dispatch sends a repository_dispatch event with the event
+// type and client payload.
+//
+// See https://docs.github.com/en/rest/repos/repos#create-a-repository-dispatch-event
+func (s *repositoryService) Dispatch(ctx context.Context, repo, eventType string, payload map[string]interface{}) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/dispatches", repo)
+	in := &repositoryDispatchInput{
+		EventType:     eventType,
+		ClientPayload: payload,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+// ListSecrets returns the names of the repository Actions
+// secrets.
+//
+// See https://docs.github.com/en/rest/actions/secrets#list-repository-secrets
+func (s *repositoryService) ListSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets?%s", repo, encodeListOptions(opts))
+	out := new(secretList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSecretList(out), res, err
+}
+
+// SetSecret creates or updates a repository Actions secret. The
+// value is encrypted with the repository public key using a
+// libsodium sealed box before it is sent.
+//
+// See https://docs.github.com/en/rest/actions/secrets#create-or-update-a-repository-secret
+func (s *repositoryService) SetSecret(ctx context.Context, repo, name, value string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets/public-key", repo)
+	key := new(secretKey)
+	res, err := s.client.do(ctx, "GET", path, nil, key)
+	if err != nil {
+		return res, err
+	}
+	encrypted, err := encryptSecret(key.Key, value)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("repos/%s/actions/secrets/%s", repo, name)
+	in := &secretInput{
+		EncryptedValue: encrypted,
+		KeyID:          key.KeyID,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// DeleteSecret deletes a repository Actions secret.
+//
+// See https://docs.github.com/en/rest/actions/secrets#delete-a-repository-secret
+func (s *repositoryService) DeleteSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// DownloadArchive downloads the tarball or zipball of the repository
+// at the given ref. GitHub redirects the request to the codeload host,
+// which the http client follows.
+func (s *repositoryService) DownloadArchive(ctx context.Context, repo, ref, format string) (io.ReadCloser, *scm.Response, error) {
+	var kind string
+	switch format {
+	case scm.ArchiveFormatTarGz:
+		kind = "tarball"
+	case scm.ArchiveFormatZip:
+		kind = "zipball"
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+	req := &scm.Request{
+		Method: http.MethodGet,
+		Path:   fmt.Sprintf("repos/%s/%s/%s", repo, kind, ref),
+	}
+	return s.client.stream(ctx, req)
+}
+
+// Find returns the repository by name. GitHub redirects the
+// requests for a renamed or transferred repository, which are
+// followed, so the returned repository has the new name and the
+// response is marked as Redirected.
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s", repo)
 	out := new(repository)
-	res, err := s.client.do(ctx, "GET", path, nil, out)
-	return convertRepository(out), res, err
+	raw, res, err := s.client.doRaw(ctx, "GET", path, nil, out)
+	to := convertRepository(out)
+	to.Raw = raw
+	return to, res, err
+}
+
+// FindWithViewerState returns the repository, including whether
+// the authenticated user stars and watches it.
+//
+// See https://developer.github.com/v3/activity/starring/#check-if-you-are-starring-a-repository
+// See https://developer.github.com/v3/activity/watching/#get-a-repository-subscription
+func (s *repositoryService) FindWithViewerState(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
+	to, res, err := s.Find(ctx, repo)
+	if err != nil {
+		return to, res, err
+	}
+
+	path := fmt.Sprintf("user/starred/%s", repo)
+	res, err = s.client.do(ctx, "GET", path, nil, nil)
+	if err == nil {
+		to.Starred = true
+	} else if !errors.Is(err, scm.ErrNotFound) {
+		return to, res, err
+	}
+
+	path = fmt.Sprintf("repos/%s/subscription", repo)
+	out := new(subscription)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if errors.Is(err, scm.ErrNotFound) {
+		return to, res, nil
+	} else if err != nil {
+		return to, res, err
+	}
+	to.Subscribed = out.Subscribed
+	return to, res, nil
+}
+
+// Exists returns true if the repository exists and is visible
+// to the authenticated user.
+func (s *repositoryService) Exists(ctx context.Context, repo string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	res, err := s.client.do(ctx, "HEAD", path, nil, nil)
+	if errors.Is(err, scm.ErrNotFound) {
+		return false, res, nil
+	} else if err != nil {
+		return false, res, err
+	}
+	return true, res, nil
 }
 
 // FindHook returns a repository hook.
@@ -146,6 +528,22 @@ func (s *repositoryService) FindUserPermission(ctx context.Context, repo string,
 func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("user/repos?%s", encodeListOptions(opts))
 	out := []*repository{}
+	raw, res, err := s.client.doRawList(ctx, "GET", path, nil, &out)
+	to := convertRepositoryList(out)
+	for i := range raw {
+		to[i].Raw = raw[i]
+	}
+	return to, res, err
+}
+
+// ListWithOptions returns the user repository list filtered by
+// the given options.
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	if err := validateAffiliation(opts.Affiliation); err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("user/repos?%s", encodeRepositoryListOptions(opts))
+	out := []*repository{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertRepositoryList(out), res, err
 }
@@ -170,7 +568,7 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 //
 // See https://developer.github.com/v3/repos/statuses/#get-the-combined-status-for-a-specific-ref
 func (s *repositoryService) FindCombinedStatus(ctx context.Context, repo, ref string) (*scm.CombinedStatus, *scm.Response, error) {
-	path := fmt.Sprintf("repos/%s/statuses/%s", repo, ref)
+	path := fmt.Sprintf("repos/%s/commits/%s/status", repo, ref)
 	out := &combinedStatus{}
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	return convertCombinedStatus(out), res, err
@@ -191,6 +589,9 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	in.Name = "web"
 	in.Config.Secret = input.Secret
 	in.Config.ContentType = "json"
+	if input.ContentType != "" {
+		in.Config.ContentType = input.ContentType
+	}
 	in.Config.URL = input.Target
 	in.Events = append(
 		input.NativeEvents,
@@ -203,6 +604,9 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 
 // CreateStatus creates a new commit status.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+	if err := scm.CheckStatusLabel(input.Label, maxStatusLabelLength); err != nil {
+		return nil, nil, err
+	}
 	path := fmt.Sprintf("repos/%s/statuses/%s", repo, ref)
 	in := &status{
 		State:       convertFromState(input.State),
@@ -215,6 +619,17 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 	return convertStatus(out), res, err
 }
 
+func (s *repositoryService) CreateStatuses(ctx context.Context, repo, ref string, inputs []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	statuses, err := batch.CreateStatuses(ctx, inputs, func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		return s.CreateStatus(ctx, repo, ref, input)
+	})
+	return statuses, nil, err
+}
+
+func (s *repositoryService) DeleteStatus(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // DeleteHook deletes a repository webhook.
 func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/hooks/%s", repo, id)
@@ -235,7 +650,7 @@ func convertRepositoryList(from []*repository) []*scm.Repository {
 // to the common repository structure.
 func convertRepository(from *repository) *scm.Repository {
 	return &scm.Repository{
-		ID:        strconv.Itoa(from.ID),
+		ID:        strconv.FormatInt(from.ID, 10),
 		Name:      from.Name,
 		Namespace: from.Owner.Login,
 		FullName:  from.FullName,
@@ -244,13 +659,76 @@ func convertRepository(from *repository) *scm.Repository {
 			Pull:  from.Permissions.Pull,
 			Admin: from.Permissions.Admin,
 		},
-		Link:     from.HTMLURL,
-		Branch:   from.DefaultBranch,
-		Private:  from.Private,
-		Clone:    from.CloneURL,
-		CloneSSH: from.SSHURL,
-		Created:  from.CreatedAt,
-		Updated:  from.UpdatedAt,
+		Link:       from.HTMLURL,
+		Branch:     from.DefaultBranch,
+		Private:    from.Private,
+		Clone:      from.CloneURL,
+		CloneSSH:   from.SSHURL,
+		Created:    from.CreatedAt,
+		Updated:    from.UpdatedAt,
+		OpenIssues: from.OpenIssues,
+	}
+}
+
+func convertCollaboratorList(from []*collaborator) []*scm.Collaborator {
+	to := []*scm.Collaborator{}
+	for _, v := range from {
+		to = append(to, convertCollaborator(v))
+	}
+	return to
+}
+
+func convertCollaborator(from *collaborator) *scm.Collaborator {
+	return &scm.Collaborator{
+		User:       *convertUser(&from.user),
+		Permission: convertCollaboratorPermission(from),
+	}
+}
+
+// helper function returns the permission level of the
+// collaborator, matching the levels returned by
+// FindUserPermission.
+func convertCollaboratorPermission(from *collaborator) string {
+	switch {
+	case from.Permissions.Admin:
+		return "admin"
+	case from.Permissions.Push:
+		return "write"
+	case from.Permissions.Pull:
+		return "read"
+	default:
+		return "none"
+	}
+}
+
+func convertContributorList(from []*contributor) []*scm.Contributor {
+	to := []*scm.Contributor{}
+	for _, v := range from {
+		to = append(to, &scm.Contributor{
+			User:    *convertUser(&v.user),
+			Commits: v.Contributions,
+		})
+	}
+	return to
+}
+
+func convertInvitationList(from []*invitation) []*scm.Invitation {
+	to := []*scm.Invitation{}
+	for _, v := range from {
+		to = append(to, convertInvitation(v))
+	}
+	return to
+}
+
+func convertInvitation(from *invitation) *scm.Invitation {
+	return &scm.Invitation{
+		ID:         from.ID,
+		Repo:       *convertRepository(&from.Repository),
+		Invitee:    *convertUser(&from.Invitee),
+		Inviter:    *convertUser(&from.Inviter),
+		Permission: from.Permissions,
+		Link:       from.HTMLURL,
+		Created:    from.CreatedAt,
 	}
 }
 
@@ -264,10 +742,12 @@ func convertHookList(from []*hook) []*scm.Hook {
 
 func convertHook(from *hook) *scm.Hook {
 	return &scm.Hook{
-		ID:     strconv.Itoa(from.ID),
-		Active: from.Active,
-		Target: from.Config.URL,
-		Events: from.Events,
+		ID:          strconv.FormatInt(from.ID, 10),
+		Active:      from.Active,
+		Target:      from.Config.URL,
+		Events:      from.Events,
+		ContentType: from.Config.ContentType,
+		Parsed:      parseHookEvents(from.Events),
 	}
 }
 
@@ -292,6 +772,52 @@ func convertHookEvents(from scm.HookEvents) []string {
 		events = append(events, "create")
 		events = append(events, "delete")
 	}
+	if from.Member {
+		events = append(events, "member")
+	}
+	if from.Team {
+		events = append(events, "team")
+	}
+	return events
+}
+
+// helper function parses the native hook events into the
+// structured hook events.
+func parseHookEvents(from []string) scm.HookEvents {
+	var events scm.HookEvents
+	for _, event := range from {
+		switch event {
+		case "*":
+			events = scm.HookEvents{
+				Branch:             true,
+				Issue:              true,
+				IssueComment:       true,
+				Member:             true,
+				PullRequest:        true,
+				PullRequestComment: true,
+				Push:               true,
+				Tag:                true,
+				Team:               true,
+			}
+		case "push":
+			events.Push = true
+		case "pull_request":
+			events.PullRequest = true
+		case "pull_request_review_comment":
+			events.PullRequestComment = true
+		case "issues":
+			events.Issue = true
+		case "issue_comment":
+			events.IssueComment = true
+		case "create", "delete":
+			events.Branch = true
+			events.Tag = true
+		case "member", "membership":
+			events.Member = true
+		case "team":
+			events.Team = true
+		}
+	}
 	return events
 }
 
@@ -310,6 +836,38 @@ type status struct {
 	Context     string    `json:"context"`
 }
 
+func convertSecretList(from *secretList) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from.Secrets {
+		to = append(to, &scm.Secret{
+			Name:    v.Name,
+			Created: v.Created,
+			Updated: v.Updated,
+		})
+	}
+	return to
+}
+
+// helper function to encrypt the secret value with the base64
+// encoded repository public key, returning the base64 encoded
+// sealed box.
+func encryptSecret(key, value string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	if len(raw) != 32 {
+		return "", fmt.Errorf("invalid public key length %d", len(raw))
+	}
+	publicKey := new([32]byte)
+	copy(publicKey[:], raw)
+	sealed, err := box.SealAnonymous(nil, []byte(value), publicKey, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
 func convertCombinedStatus(from *combinedStatus) *scm.CombinedStatus {
 	return &scm.CombinedStatus{
 		Sha:      from.Sha,
@@ -323,31 +881,27 @@ func convertStatusList(from []*status) []*scm.Status {
 	for _, v := range from {
 		to = append(to, convertStatus(v))
 	}
+	scm.SortStatuses(to)
 	return to
 }
 
 func convertStatus(from *status) *scm.Status {
 	return &scm.Status{
-		State:  convertState(from.State),
-		Label:  from.Context,
-		Desc:   from.Description,
-		Target: from.TargetURL,
+		State:   convertState(from.State),
+		Label:   from.Context,
+		Desc:    from.Description,
+		Target:  from.TargetURL,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
 	}
 }
 
+// helper function to convert the commit status state. The
+// GitHub state names match the canonical state names, and
+// unrecognized states are converted to scm.StateUnknown.
 func convertState(from string) scm.State {
-	switch from {
-	case "error":
-		return scm.StateError
-	case "failure":
-		return scm.StateFailure
-	case "pending":
-		return scm.StatePending
-	case "success":
-		return scm.StateSuccess
-	default:
-		return scm.StateUnknown
-	}
+	state, _ := scm.ParseState(from)
+	return state
 }
 
 func convertFromState(from scm.State) string {
@@ -5,14 +5,21 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
 	"github.com/jenkins-x/go-scm/scm"
+	"golang.org/x/crypto/nacl/box"
 )
 
 func TestRepositoryFind(t *testing.T) {
@@ -45,7 +52,48 @@ func TestRepositoryFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
-func TestRepositoryPerms(t *testing.T) {
+func TestRepositoryFind_Moved(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/old-name").
+		Reply(301).
+		Type("application/json").
+		SetHeader("Location", "https://api.github.com/repositories/1296269").
+		File("testdata/repo_moved.json")
+
+	gock.New("https://api.github.com").
+		Get("/repositories/1296269").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Find(context.Background(), "octocat/old-name")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !res.Redirected {
+		t.Errorf("Want response marked as redirected")
+	}
+	if got, want := res.URL, "https://api.github.com/repositories/1296269"; got != want {
+		t.Errorf("Want redirect URL %q, got %q", want, got)
+	}
+}
+
+func TestRepositoryFindWithViewerState(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
@@ -55,8 +103,20 @@ func TestRepositoryPerms(t *testing.T) {
 		SetHeaders(mockHeaders).
 		File("testdata/repo.json")
 
+	gock.New("https://api.github.com").
+		Get("/user/starred/octocat/hello-world").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/subscription").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/subscription.json")
+
 	client := NewDefault()
-	got, res, err := client.Repositories.FindPerms(context.Background(), "octocat/hello-world")
+	got, res, err := client.Repositories.FindWithViewerState(context.Background(), "octocat/hello-world")
 	if err != nil {
 		t.Error(err)
 		return
@@ -65,8 +125,10 @@ func TestRepositoryPerms(t *testing.T) {
 	want := new(scm.Repository)
 	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
 	json.Unmarshal(raw, want)
+	want.Starred = true
+	want.Subscribed = true
 
-	if diff := cmp.Diff(got, want.Perm); diff != "" {
+	if diff := cmp.Diff(got, want); diff != "" {
 		t.Errorf("Unexpected Results")
 		t.Log(diff)
 	}
@@ -75,121 +137,200 @@ func TestRepositoryPerms(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
-func TestRepositoryNotFound(t *testing.T) {
+func TestRepositoryFindWithViewerState_NotWatched(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Get("/repos/dev/null").
+		Get("/repos/octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://api.github.com").
+		Get("/user/starred/octocat/hello-world").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/subscription").
 		Reply(404).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		File("testdata/error.json")
 
 	client := NewDefault()
-	_, _, err := client.Repositories.Find(context.Background(), "dev/null")
-	if err == nil {
-		t.Errorf("Expect Not Found error")
+	got, _, err := client.Repositories.FindWithViewerState(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
 		return
 	}
-	if got, want := err.Error(), "Not Found"; got != want {
-		t.Errorf("Want error %q, got %q", want, got)
+	if got.Starred || got.Subscribed {
+		t.Errorf("Expect repository not starred or watched")
 	}
 }
 
-func TestRepositoryList(t *testing.T) {
+func TestRepositoryFind_Raw(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Get("/user/repos").
-		MatchParam("page", "1").
-		MatchParam("per_page", "30").
+		Get("/repos/octocat/hello-world").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		SetHeaders(mockPageHeaders).
-		File("testdata/repos.json")
+		File("testdata/repo.json")
 
 	client := NewDefault()
-	got, res, err := client.Repositories.List(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	client.KeepRaw = true
+	got, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	want := []*scm.Repository{}
-	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
-	json.Unmarshal(raw, &want)
-
-	if diff := cmp.Diff(got, want); diff != "" {
-		t.Errorf("Unexpected Results")
-		t.Log(diff)
+	extra := struct {
+		ForksCount int      `json:"forks_count"`
+		Topics     []string `json:"topics"`
+	}{}
+	if err := json.Unmarshal(got.Raw, &extra); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := extra.ForksCount, 9; got != want {
+		t.Errorf("Want raw forks_count %d, got %d", want, got)
 	}
+	if len(extra.Topics) == 0 {
+		t.Errorf("Want raw topics")
+	}
+}
 
-	t.Run("Request", testRequest(res))
-	t.Run("Rate", testRate(res))
-	t.Run("Page", testPage(res))
+func TestRepositoryFind_NoRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Raw != nil {
+		t.Errorf("Expect nil raw payload by default")
+	}
 }
 
-func TestStatusList(t *testing.T) {
+func TestRepositoryFind_LargeID(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Get("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
-		MatchParam("page", "1").
-		MatchParam("per_page", "30").
+		Get("/repos/octocat/hello-world").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		SetHeaders(mockPageHeaders).
-		File("testdata/statuses.json")
+		BodyString(`{"id":4294967297,"name":"hello-world","full_name":"octocat/hello-world","owner":{"id":1,"login":"octocat"}}`)
 
 	client := NewDefault()
-	got, res, err := client.Repositories.ListStatus(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", scm.ListOptions{Size: 30, Page: 1})
+	got, _, err := client.Repositories.Find(context.Background(), "octocat/hello-world")
 	if err != nil {
 		t.Error(err)
 		return
 	}
+	if got, want := got.ID, "4294967297"; got != want {
+		t.Errorf("Want repository id %q, got %q", want, got)
+	}
+}
 
-	want := []*scm.Status{}
-	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
-	json.Unmarshal(raw, &want)
+func TestRepositoryExists(t *testing.T) {
+	defer gock.Off()
 
-	if diff := cmp.Diff(got, want); diff != "" {
-		t.Errorf("Unexpected Results")
-		t.Log(diff)
+	gock.New("https://api.github.com").
+		Head("/repos/octocat/hello-world").
+		Reply(200).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Exists(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Expect repository exists")
 	}
 
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
-	t.Run("Page", testPage(res))
 }
 
-func TestStatusCreate(t *testing.T) {
+func TestRepositoryExists_NotFound(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Post("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		Head("/repos/octocat/hello-world").
+		Reply(404).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Exists(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got {
+		t.Errorf("Expect repository does not exist")
+	}
+}
+
+func TestRepositoryExists_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Head("/repos/octocat/hello-world").
+		Reply(500).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, _, err := client.Repositories.Exists(context.Background(), "octocat/hello-world")
+	if err == nil {
+		t.Errorf("Expect error for unexpected status")
+	}
+}
+
+func TestRepositoryCreateFromTemplate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/template/generate").
+		MatchHeader("Accept", `application/vnd\.github\.baptiste-preview\+json`).
+		File("testdata/repo_generate.json").
 		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		File("testdata/status.json")
+		File("testdata/repo.json")
 
-	in := &scm.StatusInput{
-		Desc:   "Build has completed successfully",
-		Label:  "continuous-integration/drone",
-		State:  scm.StateSuccess,
-		Target: "https://ci.example.com/1000/output",
+	input := &scm.RepositoryInput{
+		Namespace:   "octocat",
+		Name:        "Hello-World",
+		Description: "This is your first repository",
 	}
 
 	client := NewDefault()
-	got, res, err := client.Repositories.CreateStatus(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", in)
+	got, res, err := client.Repositories.CreateFromTemplate(context.Background(), "octocat/template", input)
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	want := new(scm.Status)
-	raw, _ := ioutil.ReadFile("testdata/status.json.golden")
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
 	json.Unmarshal(raw, want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
@@ -201,25 +342,35 @@ func TestStatusCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
-func TestRepositoryHookFind(t *testing.T) {
+func TestRepositoryCreate(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Get("/repos/octocat/hello-world/hooks/1").
-		Reply(200).
+		Post("/orgs/octocat/repos").
+		File("testdata/repo_create.json").
+		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		File("testdata/hook.json")
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:         "octocat",
+		Name:              "Hello-World",
+		Description:       "This is your first repository",
+		AutoInit:          true,
+		GitignoreTemplate: "Go",
+		LicenseTemplate:   "mit",
+	}
 
 	client := NewDefault()
-	got, res, err := client.Repositories.FindHook(context.Background(), "octocat/hello-world", "1")
+	got, res, err := client.Repositories.Create(context.Background(), input)
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	want := new(scm.Hook)
-	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
 	json.Unmarshal(raw, want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
@@ -231,26 +382,28 @@ func TestRepositoryHookFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
-func TestRepositoryFindUserPermission(t *testing.T) {
+func TestRepositoryPerms(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Get("/repos/octocat/hello-world/collaborators/octocat/permission").
+		Get("/repos/octocat/hello-world").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		File("testdata/user_perm.json")
+		File("testdata/repo.json")
 
 	client := NewDefault()
-	got, res, err := client.Repositories.FindUserPermission(context.Background(), "octocat/hello-world", "octocat")
+	got, res, err := client.Repositories.FindPerms(context.Background(), "octocat/hello-world")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	want := "admin"
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
 
-	if diff := cmp.Diff(got, want); diff != "" {
+	if diff := cmp.Diff(got, want.Perm); diff != "" {
 		t.Errorf("Unexpected Results")
 		t.Log(diff)
 	}
@@ -259,28 +412,52 @@ func TestRepositoryFindUserPermission(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
-func TestRepositoryHookList(t *testing.T) {
+func TestRepositoryNotFound(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Get("/repos/octocat/hello-world/hooks").
+		Get("/repos/dev/null").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error.json")
+
+	client := NewDefault()
+	_, _, err := client.Repositories.Find(context.Background(), "dev/null")
+	if err == nil {
+		t.Errorf("Expect Not Found error")
+		return
+	}
+	if got, want := err.Error(), "Not Found"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Want error to wrap %q, got %q", scm.ErrNotFound, err)
+	}
+}
+
+func TestRepositoryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user/repos").
 		MatchParam("page", "1").
 		MatchParam("per_page", "30").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		SetHeaders(mockPageHeaders).
-		File("testdata/hooks.json")
+		File("testdata/repos.json")
 
 	client := NewDefault()
-	got, res, err := client.Repositories.ListHooks(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	got, res, err := client.Repositories.List(context.Background(), scm.ListOptions{Page: 1, Size: 30})
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	want := []*scm.Hook{}
-	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
 	json.Unmarshal(raw, &want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
@@ -293,34 +470,604 @@ func TestRepositoryHookList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
-func TestRepositoryHookDelete(t *testing.T) {
+func TestRepositoryListWithOptions(t *testing.T) {
 	defer gock.Off()
 
 	gock.New("https://api.github.com").
-		Delete("/repos/octocat/hello-world/hooks/1").
-		Reply(204).
+		Get("/user/repos").
+		MatchParam("affiliation", "collaborator").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
 		Type("application/json").
-		SetHeaders(mockHeaders)
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/repos.json")
 
 	client := NewDefault()
-	res, err := client.Repositories.DeleteHook(context.Background(), "octocat/hello-world", "1")
+	opts := scm.RepositoryListOptions{Page: 1, Size: 30, Affiliation: scm.AffiliationCollaborator}
+	got, res, err := client.Repositories.ListWithOptions(context.Background(), opts)
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	if got, want := res.Status, 204; got != want {
-		t.Errorf("Want response status %d, got %d", want, got)
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
 }
 
-func TestRepositoryHookCreate(t *testing.T) {
-	defer gock.Off()
-
-	gock.New("https://api.github.com").
+func TestRepositoryListWithOptions_InvalidAffiliation(t *testing.T) {
+	client := NewDefault()
+	opts := scm.RepositoryListOptions{Affiliation: "admin"}
+	_, _, err := client.Repositories.ListWithOptions(context.Background(), opts)
+	if err == nil {
+		t.Errorf("Expect invalid affiliation error")
+	}
+}
+
+func TestStatusList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/statuses.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListStatus(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Status{}
+	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestStatusList_Sorted(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/statuses_unordered.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.ListStatus(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{
+		"continuous-integration/drone Build 2",
+		"continuous-integration/drone Build 4",
+		"continuous-integration/travis Build 3",
+		"continuous-integration/travis Build 1",
+	}
+	var order []string
+	for _, status := range got {
+		order = append(order, status.Label+" "+status.Desc)
+	}
+	if diff := cmp.Diff(order, want); diff != "" {
+		t.Errorf("Unexpected status order")
+		t.Log(diff)
+	}
+}
+
+func TestStatusCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/status.json")
+
+	in := &scm.StatusInput{
+		Desc:   "Build has completed successfully",
+		Label:  "continuous-integration/drone",
+		State:  scm.StateSuccess,
+		Target: "https://ci.example.com/1000/output",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.CreateStatus(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Status)
+	raw, _ := ioutil.ReadFile("testdata/status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestStatusCreate_LabelTooLong(t *testing.T) {
+	defer gock.Off()
+
+	label := strings.Repeat("a", 255)
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		BodyString(`"context":"` + label + `"`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/status.json")
+
+	in := []*scm.StatusInput{
+		{Label: label, State: scm.StateSuccess},
+		{Label: label + "a", State: scm.StateSuccess},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Repositories.CreateStatuses(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", in)
+	if !errors.As(err, new(scm.StatusLabelTooLong)) {
+		t.Errorf("Expect StatusLabelTooLong error, got %v", err)
+	}
+	if got[0] == nil {
+		t.Errorf("Expect label of the maximum length to be accepted")
+	}
+	if got[1] != nil {
+		t.Errorf("Expect no status for the label over the maximum length")
+	}
+	if gock.HasUnmatchedRequest() {
+		t.Errorf("Expect the label over the maximum length not to be sent")
+	}
+}
+
+func TestStatusCreateMany(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		BodyString(`"context":"ci/(build|test)"`).
+		Times(2).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/status.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		BodyString(`"context":"ci/lint"`).
+		Reply(422).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"Validation Failed"}`)
+
+	in := []*scm.StatusInput{
+		{Label: "ci/build", State: scm.StateSuccess},
+		{Label: "ci/lint", State: scm.StateFailure},
+		{Label: "ci/test", State: scm.StateSuccess},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Repositories.CreateStatuses(context.Background(), "octocat/hello-world", "6dcb09b5b57875f334f61aebed695e2e4193db5e", in)
+	if err == nil {
+		t.Errorf("Expect aggregated error for the failed status")
+	} else if want := `status "ci/lint": Validation Failed`; err.Error() != want {
+		t.Errorf("Want error %q, got %q", want, err.Error())
+	}
+	if len(got) != 3 || got[0] == nil || got[1] != nil || got[2] == nil {
+		t.Errorf("Expect created statuses in input order, got %v", got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all statuses to be posted")
+	}
+}
+
+func TestRepositoryHookFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.FindHook(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryFindUserPermission(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/collaborators/octocat/permission").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_perm.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.FindUserPermission(context.Background(), "octocat/hello-world", "octocat")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := "admin"
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/hooks.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListHooks(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestRepositoryHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/hooks/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.DeleteHook(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryStar(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/user/starred/octocat/hello-world").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.Star(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUnstar(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/user/starred/octocat/hello-world").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.Unstar(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositorySetArchived(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world").
+		JSON(map[string]bool{"archived": true}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	res, err := client.Repositories.SetArchived(context.Background(), "octocat/hello-world", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositorySetArchived_Unarchive(t *testing.T) {
+	_, err := NewDefault().Repositories.SetArchived(context.Background(), "octocat/hello-world", false)
+	if !errors.Is(err, scm.ErrNotSupported) {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestRepositoryDispatchWorkflow(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/actions/workflows/deploy.yml/dispatches").
+		MatchType("json").
+		BodyString(`{"ref":"main","inputs":{"environment":"staging"}}`).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	inputs := map[string]interface{}{"environment": "staging"}
+	res, err := client.Repositories.DispatchWorkflow(context.Background(), "octocat/hello-world", "deploy.yml", "main", inputs)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryDispatch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/dispatches").
+		MatchType("json").
+		BodyString(`{"event_type":"deploy","client_payload":{"sha":"6dcb09b5b57875f334f61aebed695e2e4193db5e"}}`).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	payload := map[string]interface{}{"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	res, err := client.Repositories.Dispatch(context.Background(), "octocat/hello-world", "deploy", payload)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryListSecrets(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/secrets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/secrets.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListSecrets(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositorySetSecret(t *testing.T) {
+	defer gock.Off()
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "568250167242549743",
+			"key":    base64.StdEncoding.EncodeToString(publicKey[:]),
+		})
+
+	// the secret matcher decrypts the request body with the
+	// private key to verify the sealed box. It is added to a
+	// copy of the default matchers, which are shared by all
+	// mocks.
+	matcher := gock.NewEmptyMatcher()
+	for _, fn := range gock.Matchers {
+		matcher.Add(fn)
+	}
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		if req.Body == nil {
+			return false, nil
+		}
+		in := new(secretInput)
+		if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+			return false, err
+		}
+		if in.KeyID != "568250167242549743" {
+			return false, nil
+		}
+		sealed, err := base64.StdEncoding.DecodeString(in.EncryptedValue)
+		if err != nil {
+			return false, err
+		}
+		value, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+		return ok && string(value) == "s3cr3t", nil
+	})
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/actions/secrets/GH_TOKEN").
+		MatchType("json").
+		SetMatcher(matcher).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.SetSecret(context.Background(), "octocat/hello-world", "GH_TOKEN", "s3cr3t")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestRepositoryDeleteSecret(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/actions/secrets/GH_TOKEN").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.DeleteSecret(context.Background(), "octocat/hello-world", "GH_TOKEN")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
 		Post("/repos/octocat/hello-world/hooks").
 		Reply(201).
 		Type("application/json").
@@ -354,6 +1101,34 @@ func TestRepositoryHookCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookCreate_ContentType(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks").
+		BodyString(`"content_type":"form"`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Target:      "https://example.com",
+		Secret:      "topsecret",
+		ContentType: "form",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Repositories.CreateHook(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if gock.IsPending() {
+		t.Errorf("Expect the hook to be created with the form content type")
+	}
+}
+
 func TestConvertState(t *testing.T) {
 	tests := []struct {
 		src string
@@ -457,6 +1232,10 @@ func TestHookEvents(t *testing.T) {
 			in:  scm.HookEvents{PullRequest: true},
 			out: []string{"pull_request"},
 		},
+		{
+			in:  scm.HookEvents{Member: true, Team: true},
+			out: []string{"member", "team"},
+		},
 		{
 			in: scm.HookEvents{
 				Branch:             true,
@@ -479,3 +1258,328 @@ func TestHookEvents(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/collaborators/someuser").
+		JSON(map[string]string{"permission": "push"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	invited, res, err := client.Repositories.AddCollaborator(context.Background(), "octocat/hello-world", "someuser", "push")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !invited {
+		t.Errorf("Expect an invitation to be created")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryAddCollaborator_Existing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/collaborators/someuser").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	invited, _, err := client.Repositories.AddCollaborator(context.Background(), "octocat/hello-world", "someuser", "push")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if invited {
+		t.Errorf("Expect no invitation for an existing collaborator")
+	}
+}
+
+func TestRepositoryRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/collaborators/someuser").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.RemoveCollaborator(context.Background(), "octocat/hello-world", "someuser")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryListInvitations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/invitations").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/invitations.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListInvitations(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Invitation{}
+	raw, _ := ioutil.ReadFile("testdata/invitations.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestRepositoryDeleteInvitation(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/invitations/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.DeleteInvitation(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryIsCollaborator_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/collaborators/someuser").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.IsCollaborator(context.Background(), "octocat/hello-world", "someuser")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got {
+		t.Errorf("Expect user is not a collaborator")
+	}
+}
+
+func TestRepositoryDownloadArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/tarball/master").
+		Reply(302).
+		SetHeaders(mockHeaders).
+		SetHeader("Location", "https://codeload.github.com/octocat/hello-world/legacy.tar.gz/master")
+
+	gock.New("https://codeload.github.com").
+		Get("/octocat/hello-world/legacy.tar.gz/master").
+		Reply(200).
+		Type("application/x-gzip").
+		File("testdata/archive.tar.gz")
+
+	client := NewDefault()
+	body, _, err := client.Repositories.DownloadArchive(context.Background(), "octocat/hello-world", "master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer body.Close()
+
+	got, _ := ioutil.ReadAll(body)
+	want, _ := ioutil.ReadFile("testdata/archive.tar.gz")
+	if !bytes.Equal(got, want) {
+		t.Errorf("Unexpected archive contents")
+	}
+}
+
+func TestRepositoryDownloadArchive_InvalidFormat(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Repositories.DownloadArchive(context.Background(), "octocat/hello-world", "master", "rar")
+	if err == nil {
+		t.Errorf("Expect unsupported archive format error")
+	}
+}
+
+func TestRepositoryListCollaboratorsWithPermission(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/collaborators").
+		MatchParam("affiliation", "all").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/collaborators.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListCollaboratorsWithPermission(context.Background(), "octocat/hello-world", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Collaborator{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryListCollaboratorsWithPermission_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/collaborators").
+		MatchParam("affiliation", "all").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/collaborators.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/collaborators").
+		MatchParam("affiliation", "all").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/collaborators_page2.json")
+
+	client := NewDefault()
+	got, _, err := scm.ListAll(context.Background(), scm.ListOptions{}, func(ctx context.Context, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+		return client.Repositories.ListCollaboratorsWithPermission(ctx, "octocat/hello-world", opts)
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	logins := []string{}
+	for _, v := range got {
+		logins = append(logins, v.Login+":"+v.Permission)
+	}
+	want := []string{"octocat:admin", "hubot:write", "monalisa:read", "octokitten:write"}
+	if diff := cmp.Diff(logins, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect both pages to be requested")
+	}
+}
+
+func TestRepositoryListContributors(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/contributors").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/contributors.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListContributors(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Contributor{}
+	raw, _ := ioutil.ReadFile("testdata/contributors.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestParseHookEvents(t *testing.T) {
+	tests := []scm.HookEvents{
+		{Push: true},
+		{Branch: true, Tag: true},
+		{IssueComment: true},
+		{PullRequestComment: true, IssueComment: true},
+		{Issue: true},
+		{PullRequest: true},
+		{Member: true},
+		{Team: true},
+	}
+	for i, in := range tests {
+		got := parseHookEvents(convertHookEvents(in))
+		if diff := cmp.Diff(got, in); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseHookEvents_Wildcard(t *testing.T) {
+	want := scm.HookEvents{
+		Branch:             true,
+		Issue:              true,
+		IssueComment:       true,
+		Member:             true,
+		PullRequest:        true,
+		PullRequestComment: true,
+		Push:               true,
+		Tag:                true,
+		Team:               true,
+	}
+	if diff := cmp.Diff(parseHookEvents([]string{"*"}), want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -23,6 +23,10 @@ func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (
 	return convertReview(out), res, err
 }
 
+// List returns the inline review comments of the pull request.
+// The REST api does not report the review thread of a comment, so
+// the ThreadID and Resolved fields are only populated by
+// ListThreads.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d/comments?%s", repo, number, encodeListOptions(opts))
 	out := []*review{}
@@ -30,6 +34,14 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 	return convertReviewList(out), res, err
 }
 
+// ListComments returns the inline review comments of the pull
+// request, which are distinct from the conversation comments
+// returned by the issue comments endpoint. GitHub lists review
+// comments inline, so this is equivalent to List.
+func (s *reviewService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
+	return s.List(ctx, repo, number, opts)
+}
+
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d/comments", repo, number)
 	in := &reviewInput{
@@ -43,15 +55,173 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 	return convertReview(out), res, err
 }
 
+// CreateComment creates an inline review comment using the line and
+// side parameters. GitHub requires a commit to position the comment,
+// so the pull request head is used when no sha is provided.
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.Review, *scm.Response, error) {
+	sha := input.Sha
+	if sha == "" {
+		pull := new(pr)
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("repos/%s/pulls/%d", repo, number), nil, pull)
+		if err != nil {
+			return nil, res, err
+		}
+		sha = pull.Head.Sha
+	}
+	side := input.Side
+	if side == "" {
+		side = scm.ReviewSideRight
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/comments", repo, number)
+	in := &reviewCommentInput{
+		Body:     input.Body,
+		Path:     input.Path,
+		CommitID: sha,
+		Line:     input.Line,
+		Side:     side,
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	review := convertReview(out)
+	review.Line = out.Line
+	return review, res, nil
+}
+
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/comments/%d", repo, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *reviewService) EditComment(ctx context.Context, repo string, number, id int, body string) (*scm.Review, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/comments/%d", repo, id)
+	in := &reviewEditInput{
+		Body: body,
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertReview(out), res, err
+}
+
+func (s *reviewService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	return s.Delete(ctx, repo, number, id)
+}
+
+// ListThreads returns the review threads of the pull request.
+// The REST API does not expose review threads, so the GraphQL v4
+// API is used. The threads, and the comments of threads with more
+// than 100 comments, are requested 100 at a time until every page
+// is read.
+//
+// See https://docs.github.com/en/graphql/reference/objects#pullrequestreviewthread
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int) ([]*scm.ReviewThread, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	vars := map[string]interface{}{
+		"owner":  owner,
+		"name":   name,
+		"number": number,
+	}
+	to := []*scm.ReviewThread{}
+	for {
+		out := new(reviewThreads)
+		res, err := s.client.graphql(ctx, reviewThreadsQuery, vars, out)
+		if err != nil {
+			return nil, res, err
+		}
+		threads := out.Repository.PullRequest.ReviewThreads
+		for _, node := range threads.Nodes {
+			if node.Comments.PageInfo.HasNextPage {
+				res, err = s.listThreadComments(ctx, node)
+				if err != nil {
+					return nil, res, err
+				}
+			}
+			to = append(to, convertReviewThread(node))
+		}
+		if !threads.PageInfo.HasNextPage {
+			return to, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return to, res, err
+		}
+		vars["after"] = threads.PageInfo.EndCursor
+	}
+}
+
+// listThreadComments appends the remaining pages of comments to
+// the review thread.
+func (s *reviewService) listThreadComments(ctx context.Context, thread *reviewThread) (*scm.Response, error) {
+	vars := map[string]interface{}{
+		"id":    thread.ID,
+		"after": thread.Comments.PageInfo.EndCursor,
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		out := new(reviewThreadComments)
+		res, err := s.client.graphql(ctx, reviewThreadCommentsQuery, vars, out)
+		if err != nil {
+			return res, err
+		}
+		comments := out.Node.Comments
+		thread.Comments.Nodes = append(thread.Comments.Nodes, comments.Nodes...)
+		if !comments.PageInfo.HasNextPage {
+			return res, nil
+		}
+		vars["after"] = comments.PageInfo.EndCursor
+	}
+}
+
+// ResolveThread resolves the review thread with the given GraphQL
+// node id. The REST API does not expose review threads, so the
+// GraphQL v4 API is used.
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	out := new(struct {
+		ResolveReviewThread struct {
+			Thread reviewThread `json:"thread"`
+		} `json:"resolveReviewThread"`
+	})
+	return s.client.graphql(ctx, resolveReviewThreadMutation, map[string]interface{}{
+		"threadId": threadID,
+	}, out)
+}
+
+// UnresolveThread unresolves the review thread with the given
+// GraphQL node id.
+func (s *reviewService) UnresolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	out := new(struct {
+		UnresolveReviewThread struct {
+			Thread reviewThread `json:"thread"`
+		} `json:"unresolveReviewThread"`
+	})
+	return s.client.graphql(ctx, unresolveReviewThreadMutation, map[string]interface{}{
+		"threadId": threadID,
+	}, out)
+}
+
+// CreateCommentReply replies to the review comment thread of the
+// given top-level review comment.
+//
+// See https://developer.github.com/v3/pulls/comments/#alternative-input
+func (s *reviewService) CreateCommentReply(ctx context.Context, repo string, number, inReplyTo int, body string) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/comments", repo, number)
+	in := &reviewReplyInput{
+		Body:      body,
+		InReplyTo: inReplyTo,
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewReply(out), res, err
+}
+
 type review struct {
 	ID       int    `json:"id"`
 	CommitID string `json:"commit_id"`
 	Position int    `json:"position"`
+	Line     int    `json:"line"`
 	Path     string `json:"path"`
 	User     struct {
 		ID        int    `json:"id"`
@@ -61,6 +231,7 @@ type review struct {
 	Body      string    `json:"body"`
 	HTMLURL   string    `json:"html_url"`
 	State     string    `json:"state"`
+	InReplyTo int       `json:"in_reply_to_id"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -72,6 +243,23 @@ type reviewInput struct {
 	Position int    `json:"position"`
 }
 
+type reviewCommentInput struct {
+	Body     string `json:"body"`
+	Path     string `json:"path"`
+	CommitID string `json:"commit_id"`
+	Line     int    `json:"line"`
+	Side     string `json:"side"`
+}
+
+type reviewEditInput struct {
+	Body string `json:"body"`
+}
+
+type reviewReplyInput struct {
+	Body      string `json:"body"`
+	InReplyTo int    `json:"in_reply_to"`
+}
+
 func convertReviewList(from []*review) []*scm.Review {
 	to := []*scm.Review{}
 	for _, v := range from {
@@ -97,3 +285,185 @@ func convertReview(from *review) *scm.Review {
 		Updated: from.UpdatedAt,
 	}
 }
+
+func convertReviewReply(from *review) *scm.Comment {
+	return &scm.Comment{
+		ID:   from.ID,
+		Body: from.Body,
+		Link: from.HTMLURL,
+		Author: scm.User{
+			Login:  from.User.Login,
+			Avatar: from.User.AvatarURL,
+		},
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+		InReplyTo: from.InReplyTo,
+	}
+}
+
+type reviewThread struct {
+	ID         string `json:"id"`
+	IsResolved bool   `json:"isResolved"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	Comments   struct {
+		PageInfo pageInfo               `json:"pageInfo"`
+		Nodes    []*reviewThreadComment `json:"nodes"`
+	} `json:"comments"`
+}
+
+type reviewThreadComment struct {
+	DatabaseID int    `json:"databaseId"`
+	Body       string `json:"body"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	URL        string `json:"url"`
+	Commit     struct {
+		Oid string `json:"oid"`
+	} `json:"commit"`
+	Author struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatarUrl"`
+	} `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type reviewThreads struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				PageInfo pageInfo        `json:"pageInfo"`
+				Nodes    []*reviewThread `json:"nodes"`
+			} `json:"reviewThreads"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+type reviewThreadComments struct {
+	Node struct {
+		Comments struct {
+			PageInfo pageInfo               `json:"pageInfo"`
+			Nodes    []*reviewThreadComment `json:"nodes"`
+		} `json:"comments"`
+	} `json:"node"`
+}
+
+// pageInfo is the cursor position of a GraphQL connection.
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+func convertReviewThread(from *reviewThread) *scm.ReviewThread {
+	to := &scm.ReviewThread{
+		ID:       from.ID,
+		Path:     from.Path,
+		Line:     from.Line,
+		Resolved: from.IsResolved,
+		Comments: []*scm.Review{},
+	}
+	for _, c := range from.Comments.Nodes {
+		to.Comments = append(to.Comments, &scm.Review{
+			ID:   c.DatabaseID,
+			Body: c.Body,
+			Path: c.Path,
+			Sha:  c.Commit.Oid,
+			Line: c.Line,
+			Link: c.URL,
+			Author: scm.User{
+				Login:  c.Author.Login,
+				Avatar: c.Author.AvatarURL,
+			},
+			Created:  c.CreatedAt,
+			Updated:  c.UpdatedAt,
+			ThreadID: from.ID,
+			Resolved: from.IsResolved,
+		})
+	}
+	return to
+}
+
+const reviewThreadsQuery = `query($owner: String!, $name: String!, $number: Int!, $after: String) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100, after: $after) {
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+        nodes {
+          id
+          isResolved
+          path
+          line
+          comments(first: 100) {
+            ` + reviewThreadCommentFields + `
+          }
+        }
+      }
+    }
+  }
+}`
+
+const reviewThreadCommentsQuery = `query($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on PullRequestReviewThread {
+      comments(first: 100, after: $after) {
+        ` + reviewThreadCommentFields + `
+      }
+    }
+  }
+}`
+
+const reviewThreadCommentFields = `pageInfo {
+  hasNextPage
+  endCursor
+}
+nodes {
+  databaseId
+  body
+  path
+  line
+  url
+  commit {
+    oid
+  }
+  author {
+    login
+    avatarUrl
+  }
+  createdAt
+  updatedAt
+}`
+
+const resolveReviewThreadMutation = `mutation($threadId: ID!) {
+  resolveReviewThread(input: {threadId: $threadId}) {
+    thread {
+      id
+      isResolved
+    }
+  }
+}`
+
+const unresolveReviewThreadMutation = `mutation($threadId: ID!) {
+  unresolveReviewThread(input: {threadId: $threadId}) {
+    thread {
+      id
+      isResolved
+    }
+  }
+}`
+
+// helper function converts the inline review comment to a
+// conversation comment.
+func convertReviewComment(from *scm.Review) *scm.Comment {
+	return &scm.Comment{
+		ID:      from.ID,
+		Body:    from.Body,
+		Author:  from.Author,
+		Link:    from.Link,
+		Created: from.Created,
+		Updated: from.Updated,
+	}
+}
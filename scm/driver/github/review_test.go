@@ -79,6 +79,39 @@ func TestReviewList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestReviewListComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1/comments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/pr_comments.json")
+
+	client := NewDefault()
+	opts := scm.ListOptions{Page: 1, Size: 30}
+	want, _, err := client.Reviews.List(context.Background(), "octocat/hello-world", 1, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	got, _, err := client.Reviews.ListComments(context.Background(), "octocat/hello-world", 1, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Expect the same review comments as List")
+		t.Log(diff)
+	}
+}
+
 func TestReviewCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -116,6 +149,79 @@ func TestReviewCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestReviewCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/comments").
+		File("testdata/pr_inline_comment_input.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_inline_comment.json")
+
+	input := &scm.ReviewCommentInput{
+		Body: "Great stuff",
+		Path: "file1.txt",
+		Line: 2,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.CreateComment(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_inline_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewCreateComment_Sha(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/comments").
+		File("testdata/pr_inline_comment_input.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_inline_comment.json")
+
+	input := &scm.ReviewCommentInput{
+		Body: "Great stuff",
+		Path: "file1.txt",
+		Line: 2,
+		Side: scm.ReviewSideRight,
+		Sha:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Reviews.CreateComment(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect no pull request lookup when sha is provided")
+	}
+}
+
 func TestReviewDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -135,3 +241,207 @@ func TestReviewDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReviewCreateCommentReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/comments").
+		File("testdata/pr_comment_reply.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_comment.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.CreateCommentReply(context.Background(), "octocat/hello-world", 1, 8, "Great stuff")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/pr_comment_reply.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewEditComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/comments/1").
+		File("testdata/pr_comment_edit.json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_comment.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.EditComment(context.Background(), "octocat/hello-world", 2, 1, "Great stuff")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewDeleteComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/pulls/comments/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.DeleteComment(context.Background(), "octocat/hello-world", 2, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`resolveReviewThread.*"threadId":"MDIzOlB1bGxSZXF1ZXN0UmV2aWV3VGhyZWFkMQ=="`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_thread_resolve.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.ResolveThread(context.Background(), "octocat/hello-world", 1, "MDIzOlB1bGxSZXF1ZXN0UmV2aWV3VGhyZWFkMQ==")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewUnresolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`unresolveReviewThread`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_thread_unresolve.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.UnresolveThread(context.Background(), "octocat/hello-world", 1, "MDIzOlB1bGxSZXF1ZXN0UmV2aWV3VGhyZWFkMQ==")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`reviewThreads.*"name":"hello-world","number":1,"owner":"octocat"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_threads.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListThreads(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/review_threads.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListThreads_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`reviewThreads.*"variables":{"name":"hello-world","number":1,"owner":"octocat"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_threads_page1.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`PullRequestReviewThread.*"after":"Y3Vyc29yOnYyOpHOAAAACg==","id":"MDIzOlB1bGxSZXF1ZXN0UmV2aWV3VGhyZWFkMQ=="`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_thread_comments.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`reviewThreads.*"after":"Y3Vyc29yOnYyOpHOAAAAAQ==","name":"hello-world","number":1,"owner":"octocat"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_threads_page2.json")
+
+	client := NewDefault()
+	got, _, err := client.Reviews.ListThreads(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/review_threads.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect every page to be requested")
+	}
+}
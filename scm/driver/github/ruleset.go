@@ -0,0 +1,214 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type rulesetService struct {
+	client *wrapper
+}
+
+// Find returns the repository ruleset by id.
+//
+// See https://docs.github.com/en/rest/repos/rules#get-a-repository-ruleset
+func (s *rulesetService) Find(ctx context.Context, repo string, id int) (*scm.Ruleset, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/rulesets/%d", repo, id)
+	out := new(ruleset)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRuleset(out), res, err
+}
+
+// List returns the repository rulesets. GitHub does not include
+// the conditions and rules when listing rulesets.
+//
+// See https://docs.github.com/en/rest/repos/rules#get-all-repository-rulesets
+func (s *rulesetService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Ruleset, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/rulesets?%s", repo, encodeListOptions(opts))
+	out := []*ruleset{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRulesetList(out), res, err
+}
+
+// Create creates a new repository ruleset.
+//
+// See https://docs.github.com/en/rest/repos/rules#create-a-repository-ruleset
+func (s *rulesetService) Create(ctx context.Context, repo string, input *scm.RulesetInput) (*scm.Ruleset, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/rulesets", repo)
+	out := new(ruleset)
+	res, err := s.client.do(ctx, "POST", path, convertRulesetInput(input), out)
+	return convertRuleset(out), res, err
+}
+
+// Update updates the repository ruleset.
+//
+// See https://docs.github.com/en/rest/repos/rules#update-a-repository-ruleset
+func (s *rulesetService) Update(ctx context.Context, repo string, id int, input *scm.RulesetInput) (*scm.Ruleset, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/rulesets/%d", repo, id)
+	out := new(ruleset)
+	res, err := s.client.do(ctx, "PUT", path, convertRulesetInput(input), out)
+	return convertRuleset(out), res, err
+}
+
+// Delete deletes the repository ruleset.
+//
+// See https://docs.github.com/en/rest/repos/rules#delete-a-repository-ruleset
+func (s *rulesetService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/rulesets/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type ruleset struct {
+	ID          int                `json:"id"`
+	Name        string             `json:"name"`
+	Target      string             `json:"target"`
+	Enforcement string             `json:"enforcement"`
+	Conditions  *rulesetConditions `json:"conditions"`
+	Rules       []*rulesetRule     `json:"rules"`
+}
+
+type rulesetConditions struct {
+	RefName struct {
+		Include []string `json:"include"`
+		Exclude []string `json:"exclude"`
+	} `json:"ref_name"`
+}
+
+type rulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+type rulesetInput struct {
+	Name        string              `json:"name"`
+	Target      string              `json:"target,omitempty"`
+	Enforcement string              `json:"enforcement"`
+	Conditions  *rulesetConditions  `json:"conditions,omitempty"`
+	Rules       []*rulesetRuleInput `json:"rules"`
+}
+
+type rulesetRuleInput struct {
+	Type       string      `json:"type"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+type requiredStatusChecksParameters struct {
+	RequiredStatusChecks             []*requiredStatusCheck `json:"required_status_checks"`
+	StrictRequiredStatusChecksPolicy bool                   `json:"strict_required_status_checks_policy"`
+}
+
+type requiredStatusCheck struct {
+	Context string `json:"context"`
+}
+
+type pullRequestParameters struct {
+	RequiredApprovingReviewCount   int  `json:"required_approving_review_count"`
+	DismissStaleReviewsOnPush      bool `json:"dismiss_stale_reviews_on_push"`
+	RequireCodeOwnerReview         bool `json:"require_code_owner_review"`
+	RequireLastPushApproval        bool `json:"require_last_push_approval"`
+	RequiredReviewThreadResolution bool `json:"required_review_thread_resolution"`
+}
+
+func convertRulesetList(from []*ruleset) []*scm.Ruleset {
+	to := []*scm.Ruleset{}
+	for _, v := range from {
+		to = append(to, convertRuleset(v))
+	}
+	return to
+}
+
+// helper function converts the native ruleset. Rule types
+// without an equivalent scm.RulesetRules field are ignored.
+func convertRuleset(from *ruleset) *scm.Ruleset {
+	to := &scm.Ruleset{
+		ID:          from.ID,
+		Name:        from.Name,
+		Target:      from.Target,
+		Enforcement: from.Enforcement,
+	}
+	if from.Conditions != nil {
+		to.Include = from.Conditions.RefName.Include
+		to.Exclude = from.Conditions.RefName.Exclude
+	}
+	for _, rule := range from.Rules {
+		switch rule.Type {
+		case "deletion":
+			to.Rules.Deletion = true
+		case "non_fast_forward":
+			to.Rules.NonFastForward = true
+		case "required_linear_history":
+			to.Rules.RequiredLinearHistory = true
+		case "required_status_checks":
+			params := new(requiredStatusChecksParameters)
+			json.Unmarshal(rule.Parameters, params)
+			for _, check := range params.RequiredStatusChecks {
+				to.Rules.RequiredStatusChecks = append(to.Rules.RequiredStatusChecks, check.Context)
+			}
+			to.Rules.StrictStatusChecks = params.StrictRequiredStatusChecksPolicy
+		case "pull_request":
+			params := new(pullRequestParameters)
+			json.Unmarshal(rule.Parameters, params)
+			to.Rules.PullRequest = &scm.RulesetPullRequest{
+				RequiredApprovals:       params.RequiredApprovingReviewCount,
+				DismissStaleReviews:     params.DismissStaleReviewsOnPush,
+				RequireCodeOwnerReview:  params.RequireCodeOwnerReview,
+				RequireLastPushApproval: params.RequireLastPushApproval,
+				RequireThreadResolution: params.RequiredReviewThreadResolution,
+			}
+		}
+	}
+	return to
+}
+
+func convertRulesetInput(from *scm.RulesetInput) *rulesetInput {
+	to := &rulesetInput{
+		Name:        from.Name,
+		Target:      from.Target,
+		Enforcement: from.Enforcement,
+		Rules:       []*rulesetRuleInput{},
+	}
+	if len(from.Include) != 0 || len(from.Exclude) != 0 {
+		to.Conditions = new(rulesetConditions)
+		to.Conditions.RefName.Include = append([]string{}, from.Include...)
+		to.Conditions.RefName.Exclude = append([]string{}, from.Exclude...)
+	}
+	rules := from.Rules
+	if rules.Deletion {
+		to.Rules = append(to.Rules, &rulesetRuleInput{Type: "deletion"})
+	}
+	if rules.NonFastForward {
+		to.Rules = append(to.Rules, &rulesetRuleInput{Type: "non_fast_forward"})
+	}
+	if rules.RequiredLinearHistory {
+		to.Rules = append(to.Rules, &rulesetRuleInput{Type: "required_linear_history"})
+	}
+	if len(rules.RequiredStatusChecks) != 0 {
+		params := &requiredStatusChecksParameters{
+			StrictRequiredStatusChecksPolicy: rules.StrictStatusChecks,
+		}
+		for _, name := range rules.RequiredStatusChecks {
+			params.RequiredStatusChecks = append(params.RequiredStatusChecks, &requiredStatusCheck{Context: name})
+		}
+		to.Rules = append(to.Rules, &rulesetRuleInput{Type: "required_status_checks", Parameters: params})
+	}
+	if pr := rules.PullRequest; pr != nil {
+		to.Rules = append(to.Rules, &rulesetRuleInput{
+			Type: "pull_request",
+			Parameters: &pullRequestParameters{
+				RequiredApprovingReviewCount:   pr.RequiredApprovals,
+				DismissStaleReviewsOnPush:      pr.DismissStaleReviews,
+				RequireCodeOwnerReview:         pr.RequireCodeOwnerReview,
+				RequireLastPushApproval:        pr.RequireLastPushApproval,
+				RequiredReviewThreadResolution: pr.RequireThreadResolution,
+			},
+		})
+	}
+	return to
+}
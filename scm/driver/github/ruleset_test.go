@@ -0,0 +1,177 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestRulesetFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/rulesets/42").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ruleset.json")
+
+	client := NewDefault()
+	got, res, err := client.Rulesets.Find(context.Background(), "octocat/hello-world", 42)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Ruleset)
+	raw, _ := ioutil.ReadFile("testdata/ruleset.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRulesetList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/rulesets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/rulesets.json")
+
+	client := NewDefault()
+	got, res, err := client.Rulesets.List(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Ruleset{}
+	raw, _ := ioutil.ReadFile("testdata/rulesets.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestRulesetCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/rulesets").
+		BodyString(`"conditions":{"ref_name":{"include":\["~DEFAULT_BRANCH"\],"exclude":\[\]}}.*{"type":"required_status_checks","parameters":{"required_status_checks":\[{"context":"continuous-integration/jenkins"}\],"strict_required_status_checks_policy":true}}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ruleset.json")
+
+	input := &scm.RulesetInput{
+		Name:        "main protection",
+		Target:      "branch",
+		Enforcement: "active",
+		Include:     []string{"~DEFAULT_BRANCH"},
+		Rules: scm.RulesetRules{
+			Deletion:             true,
+			NonFastForward:       true,
+			RequiredStatusChecks: []string{"continuous-integration/jenkins"},
+			StrictStatusChecks:   true,
+			PullRequest: &scm.RulesetPullRequest{
+				RequiredApprovals:       2,
+				DismissStaleReviews:     true,
+				RequireThreadResolution: true,
+			},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Rulesets.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Ruleset)
+	raw, _ := ioutil.ReadFile("testdata/ruleset.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRulesetUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/rulesets/42").
+		BodyString(`"enforcement":"evaluate"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ruleset.json")
+
+	input := &scm.RulesetInput{
+		Name:        "main protection",
+		Enforcement: "evaluate",
+	}
+
+	client := NewDefault()
+	_, res, err := client.Rulesets.Update(context.Background(), "octocat/hello-world", 42, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRulesetDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/rulesets/42").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Rulesets.Delete(context.Background(), "octocat/hello-world", 42)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -0,0 +1,39 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Repositories(ctx context.Context, query string, opts scm.ListOptions) (*scm.RepositorySearchResult, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	path := fmt.Sprintf("search/repositories?%s", encodeListOptionsWith(opts, params))
+	out := new(repositorySearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRepositorySearchResult(out), res, err
+}
+
+type repositorySearchResult struct {
+	TotalCount        int           `json:"total_count"`
+	IncompleteResults bool          `json:"incomplete_results"`
+	Items             []*repository `json:"items"`
+}
+
+func convertRepositorySearchResult(from *repositorySearchResult) *scm.RepositorySearchResult {
+	return &scm.RepositorySearchResult{
+		Total:        from.TotalCount,
+		Repositories: convertRepositoryList(from.Items),
+	}
+}
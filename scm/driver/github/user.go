@@ -35,8 +35,28 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+// AcceptInvitation accepts a repository invitation for the
+// authenticated user.
+//
+// See https://developer.github.com/v3/repos/invitations/#accept-a-repository-invitation
+func (s *userService) AcceptInvitation(ctx context.Context, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("user/repository_invitations/%d", id)
+	return s.client.do(ctx, "PATCH", path, nil, nil)
+}
+
+// ListStarred returns the repositories starred by the
+// authenticated user.
+//
+// See https://developer.github.com/v3/activity/starring/#list-repositories-being-starred
+func (s *userService) ListStarred(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("user/starred?%s", encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 type user struct {
-	ID      int         `json:"id"`
+	ID      int64       `json:"id"`
 	Login   string      `json:"login"`
 	Name    string      `json:"name"`
 	Email   null.String `json:"email"`
@@ -5,13 +5,25 @@
 package github
 
 import (
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
 
+// maxPageSize is the largest per_page value accepted by GitHub.
+const maxPageSize = 100
+
+func pageSize(size int) int {
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
+
 // NormLogin normalizes GitHub login strings
 var NormLogin = strings.ToLower
 
@@ -24,18 +36,42 @@ func encodeListOptionsWith(opts scm.ListOptions, params url.Values) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	return params.Encode()
 }
 
+func encodeRepositoryListOptions(opts scm.RepositoryListOptions) string {
+	params := url.Values{}
+	if opts.Affiliation != "" {
+		params.Set("affiliation", opts.Affiliation)
+	}
+	return encodeListOptionsWith(scm.ListOptions{Page: opts.Page, Size: opts.Size}, params)
+}
+
+// validateAffiliation returns an error if the comma-separated
+// affiliation list contains an unknown affiliation.
+func validateAffiliation(affiliation string) error {
+	if affiliation == "" {
+		return nil
+	}
+	for _, v := range strings.Split(affiliation, ",") {
+		switch strings.TrimSpace(v) {
+		case scm.AffiliationOwner, scm.AffiliationCollaborator, scm.AffiliationOrganizationMember:
+		default:
+			return fmt.Errorf("invalid repository affiliation %q", v)
+		}
+	}
+	return nil
+}
+
 func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	if opts.Ref != "" {
 		params.Set("ref", opts.Ref)
@@ -49,13 +85,31 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.Format(time.RFC3339))
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+	if opts.Direction != "" {
+		params.Set("direction", opts.Direction)
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee", opts.Assignee)
+	}
+	if opts.Creator != "" {
+		params.Set("creator", opts.Creator)
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
 	return params.Encode()
 }
 
@@ -65,12 +119,46 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
-	if opts.Open && opts.Closed {
+	if opts.State != "" {
+		params.Set("state", opts.State)
+	} else if opts.Open && opts.Closed {
 		params.Set("state", "all")
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	if opts.Base != "" {
+		params.Set("base", opts.Base)
+	}
+	if opts.Head != "" {
+		params.Set("head", opts.Head)
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+	if opts.Direction != "" {
+		params.Set("direction", opts.Direction)
+	}
+	return params.Encode()
+}
+
+func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
+	}
+	if opts.State != "" {
+		params.Set("state", opts.State)
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+	if opts.Direction != "" {
+		params.Set("direction", opts.Direction)
+	}
 	return params.Encode()
 }
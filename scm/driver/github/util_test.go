@@ -6,6 +6,7 @@ package github
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -22,6 +23,18 @@ func Test_encodeListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeListOptions_MaxPageSize(t *testing.T) {
+	opts := scm.ListOptions{
+		Page: 1,
+		Size: 1000,
+	}
+	want := "page=1&per_page=100"
+	got := encodeListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeCommitListOptions(t *testing.T) {
 	opts := scm.CommitListOptions{
 		Page: 10,
@@ -35,6 +48,32 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeRepositoryListOptions(t *testing.T) {
+	opts := scm.RepositoryListOptions{
+		Page:        10,
+		Size:        30,
+		Affiliation: "owner,collaborator",
+	}
+	want := "affiliation=owner%2Ccollaborator&page=10&per_page=30"
+	got := encodeRepositoryListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded repository list options %q, got %q", want, got)
+	}
+}
+
+func Test_validateAffiliation(t *testing.T) {
+	for _, v := range []string{"", "owner", "collaborator", "owner,organization_member"} {
+		if err := validateAffiliation(v); err != nil {
+			t.Errorf("Expect affiliation %q to be valid, got %s", v, err)
+		}
+	}
+	for _, v := range []string{"member", "owner,admin"} {
+		if err := validateAffiliation(v); err == nil {
+			t.Errorf("Expect affiliation %q to be invalid", v)
+		}
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -49,6 +88,48 @@ func Test_encodeIssueListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Since(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Page:  10,
+		Size:  30,
+		Since: time.Date(2019, time.May, 1, 10, 30, 0, 0, time.UTC),
+	}
+	want := "page=10&per_page=30&since=2019-05-01T10%3A30%3A00Z"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Sort(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Page:      10,
+		Size:      30,
+		Sort:      "updated",
+		Direction: "desc",
+	}
+	want := "direction=desc&page=10&per_page=30&sort=updated"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Page:     10,
+		Size:     30,
+		Assignee: "octocat",
+		Creator:  "hubot",
+		Labels:   []string{"bug", "ui"},
+	}
+	want := "assignee=octocat&creator=hubot&labels=bug%2Cui&page=10&per_page=30"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions_Closed(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
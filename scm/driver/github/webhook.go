@@ -11,6 +11,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -50,9 +51,15 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePullRequestReviewCommentHook(data)
 	case "deployment":
 		hook, err = s.parseDeploymentHook(data)
+	case "ping":
+		hook, err = s.parsePingHook(data)
 	// case "issues":
 	case "issue_comment":
 		hook, err = s.parseIssueCommentHook(data)
+	case "member", "membership":
+		hook, err = s.parseMemberHook(data)
+	case "team":
+		hook, err = s.parseTeamHook(data)
 	default:
 		return nil, scm.UnknownWebhook{event}
 	}
@@ -70,7 +77,15 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		return hook, nil
 	}
 
-	sig := req.Header.Get("X-Hub-Signature")
+	// prefer the sha256 signature when present, and fall
+	// back to the legacy sha1 signature.
+	sig := req.Header.Get("X-Hub-Signature-256")
+	if sig == "" {
+		sig = req.Header.Get("X-Hub-Signature")
+	}
+	if sig == "" {
+		return hook, scm.MissingHeader{"X-Hub-Signature-256"}
+	}
 	if !hmac.ValidatePrefix(data, []byte(key), sig) {
 		return hook, scm.ErrSignatureInvalid
 	}
@@ -130,6 +145,16 @@ func (s *webhookService) parseDeploymentHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parsePingHook(data []byte) (scm.Webhook, error) {
+	src := new(pingHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPingHook(src)
+	return dst, nil
+}
+
 func (s *webhookService) parsePullRequestHook(data []byte, guid string) (scm.Webhook, error) {
 	src := new(pullRequestHook)
 	err := json.Unmarshal(data, src)
@@ -187,6 +212,26 @@ func (s *webhookService) parseIssueCommentHook(data []byte) (*scm.IssueCommentHo
 	return dst, nil
 }
 
+func (s *webhookService) parseMemberHook(data []byte) (*scm.MemberHook, error) {
+	src := new(memberHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertMemberHook(src)
+	return dst, nil
+}
+
+func (s *webhookService) parseTeamHook(data []byte) (*scm.TeamHook, error) {
+	src := new(teamHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertTeamHook(src)
+	return dst, nil
+}
+
 //
 // native data structures
 //
@@ -303,18 +348,28 @@ type (
 
 	pullRequestReviewCommentHook struct {
 		// Action see https://developer.github.com/v3/activity/events/types/#pullrequestreviewcommentevent
-		Action      string        `json:"action"`
-		PullRequest pr            `json:"pull_request"`
-		Repository  repository    `json:"repository"`
-		Comment     reviewComment `json:"comment"`
+		Action      string             `json:"action"`
+		PullRequest pr                 `json:"pull_request"`
+		Repository  repository         `json:"repository"`
+		Comment     reviewComment      `json:"comment"`
+		Changes     commentHookChanges `json:"changes"`
 	}
 
 	issueCommentHook struct {
-		Action     string       `json:"action"`
-		Issue      issue        `json:"issue"`
-		Repository repository   `json:"repository"`
-		Comment    issueComment `json:"comment"`
-		Sender     user         `json:"sender"`
+		Action     string             `json:"action"`
+		Issue      issue              `json:"issue"`
+		Repository repository         `json:"repository"`
+		Comment    issueComment       `json:"comment"`
+		Sender     user               `json:"sender"`
+		Changes    commentHookChanges `json:"changes"`
+	}
+
+	// commentHookChanges describes the previous values
+	// of an edited comment.
+	commentHookChanges struct {
+		Body struct {
+			From string `json:"from"`
+		} `json:"body"`
 	}
 
 	// reviewComment describes a Pull Request review comment
@@ -347,6 +402,36 @@ type (
 		Repository repository `json:"repository"`
 		Sender     user       `json:"sender"`
 	}
+
+	// github ping webhook payload
+	pingHook struct {
+		Zen        string     `json:"zen"`
+		HookID     int64      `json:"hook_id"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github member and membership webhook payload. The
+	// member event is sent for repository collaborators, and
+	// the membership event for team members.
+	memberHook struct {
+		Action       string        `json:"action"`
+		Scope        string        `json:"scope"`
+		Member       user          `json:"member"`
+		Team         *team         `json:"team"`
+		Organization *organization `json:"organization"`
+		Repository   *repository   `json:"repository"`
+		Sender       user          `json:"sender"`
+	}
+
+	// github team webhook payload
+	teamHook struct {
+		Action       string        `json:"action"`
+		Team         team          `json:"team"`
+		Organization *organization `json:"organization"`
+		Repository   *repository   `json:"repository"`
+		Sender       user          `json:"sender"`
+	}
 )
 
 //
@@ -499,7 +584,7 @@ func convertLabel(src label) scm.Label {
 
 func convertPullRequestReviewCommentHook(src *pullRequestReviewCommentHook) *scm.PullRequestCommentHook {
 	return &scm.PullRequestCommentHook{
-		// Action        Action
+		Action: convertAction(src.Action),
 		Repo: scm.Repository{
 			ID:        fmt.Sprint(src.Repository.ID),
 			Namespace: src.Repository.Owner.Login,
@@ -513,6 +598,7 @@ func convertPullRequestReviewCommentHook(src *pullRequestReviewCommentHook) *scm
 		PullRequest: *convertPullRequest(&src.PullRequest),
 		Comment:     *convertPullRequestComment(&src.Comment),
 		Sender:      *convertUser(&src.Comment.User),
+		Changes:     convertCommentHookChanges(&src.Changes),
 	}
 }
 
@@ -534,6 +620,47 @@ func convertIssueCommentHook(dst *issueCommentHook) *scm.IssueCommentHook {
 		Comment: *convertIssueComment(&dst.Comment),
 		Repo:    *convertRepository(&dst.Repository),
 		Sender:  *convertUser(&dst.Sender),
+		Changes: convertCommentHookChanges(&dst.Changes),
+	}
+}
+
+func convertMemberHook(src *memberHook) *scm.MemberHook {
+	dst := &scm.MemberHook{
+		Action: convertAction(src.Action),
+		Scope:  src.Scope,
+		Member: *convertUser(&src.Member),
+		Sender: *convertUser(&src.Sender),
+	}
+	if src.Team != nil {
+		dst.Team = *convertTeam(src.Team)
+	}
+	if src.Organization != nil {
+		dst.Organization = *convertOrganization(src.Organization)
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst
+}
+
+func convertTeamHook(src *teamHook) *scm.TeamHook {
+	dst := &scm.TeamHook{
+		Action: convertAction(src.Action),
+		Team:   *convertTeam(&src.Team),
+		Sender: *convertUser(&src.Sender),
+	}
+	if src.Organization != nil {
+		dst.Organization = *convertOrganization(src.Organization)
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst
+}
+
+func convertCommentHookChanges(src *commentHookChanges) scm.CommentHookChanges {
+	return scm.CommentHookChanges{
+		Body: scm.CommentHookChangesFrom{From: src.Body.From},
 	}
 }
 
@@ -579,6 +706,24 @@ func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
 	return dst
 }
 
+func convertPingHook(src *pingHook) *scm.PingHook {
+	return &scm.PingHook{
+		HookID: strconv.FormatInt(src.HookID, 10),
+		Zen:    src.Zen,
+		Repo: scm.Repository{
+			ID:        fmt.Sprint(src.Repository.ID),
+			Namespace: src.Repository.Owner.Login,
+			Name:      src.Repository.Name,
+			Branch:    src.Repository.DefaultBranch,
+			Private:   src.Repository.Private,
+			Clone:     src.Repository.CloneURL,
+			CloneSSH:  src.Repository.SSHURL,
+			Link:      src.Repository.HTMLURL,
+		},
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
 // regexp help determine if the named git object is a tag.
 // this is not meant to be 100% accurate.
 var tagRE = regexp.MustCompile("^v?(\\d+).(.+)")
@@ -605,6 +750,10 @@ func convertAction(src string) (action scm.Action) {
 		return scm.ActionMerge
 	case "synchronize", "synchronized":
 		return scm.ActionSync
+	case "add", "added", "added_to_repository":
+		return scm.ActionAdd
+	case "remove", "removed", "removed_from_repository":
+		return scm.ActionRemove
 	default:
 		return
 	}
@@ -163,6 +163,18 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_comment.json.golden",
 			obj:    new(scm.PullRequestCommentHook),
 		},
+		{
+			event:  "pull_request_review_comment",
+			before: "testdata/webhooks/pr_comment_edited.json",
+			after:  "testdata/webhooks/pr_comment_edited.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		{
+			event:  "pull_request_review_comment",
+			before: "testdata/webhooks/pr_comment_deleted.json",
+			after:  "testdata/webhooks/pr_comment_deleted.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
 		// issue comment
 		{
 			event:  "issue_comment",
@@ -170,6 +182,18 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/issue_comment.json.golden",
 			obj:    new(scm.IssueCommentHook),
 		},
+		{
+			event:  "issue_comment",
+			before: "testdata/webhooks/issue_comment_edited.json",
+			after:  "testdata/webhooks/issue_comment_edited.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
+		{
+			event:  "issue_comment",
+			before: "testdata/webhooks/issue_comment_deleted.json",
+			after:  "testdata/webhooks/issue_comment_deleted.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
 		// deployment
 		{
 			event:  "deployment",
@@ -177,6 +201,26 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/deployment.json.golden",
 			obj:    new(scm.DeployHook),
 		},
+		// ping
+		{
+			event:  "ping",
+			before: "testdata/webhooks/ping.json",
+			after:  "testdata/webhooks/ping.json.golden",
+			obj:    new(scm.PingHook),
+		},
+		// member hooks
+		{
+			event:  "member",
+			before: "testdata/webhooks/member_added.json",
+			after:  "testdata/webhooks/member_added.json.golden",
+			obj:    new(scm.MemberHook),
+		},
+		{
+			event:  "membership",
+			before: "testdata/webhooks/membership_removed.json",
+			after:  "testdata/webhooks/membership_removed.json.golden",
+			obj:    new(scm.MemberHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -279,6 +323,52 @@ func TestWebhookValid(t *testing.T) {
 	}
 }
 
+func TestWebhookValid_SHA256(t *testing.T) {
+	// the sha can be recalculated with the below command
+	// openssl dgst -sha256 -hmac <secret> <file>
+
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "push")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r.Header.Set("X-Hub-Signature", "sha1=380f462cd2e160b84765144beabdad2e930a7ec5")
+	r.Header.Set("X-Hub-Signature-256", "sha256=951ebeea37401e9f8519e45d66d1fe09cdbfb5fe09c0620a781b180d548dd6e1")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Errorf("Expect valid signature, got %v", err)
+	}
+}
+
+func TestWebhookInvalid_SHA256(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "push")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r.Header.Set("X-Hub-Signature", "sha1=e9c4409d39729236fda483f22e7fb7513e5cd273")
+	r.Header.Set("X-Hub-Signature-256", "sha256=8ca57e2afbad9fea8860404575c2d61827995c62aacd4c514eae4c404896390b")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestWebhookMissingSignature(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "push")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if _, ok := err.(scm.MissingHeader); !ok {
+		t.Errorf("Expect missing header error, got %v", err)
+	}
+}
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
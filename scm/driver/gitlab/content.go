@@ -24,6 +24,7 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/files/%s?ref=%s", encode(repo), path, ref)
 	out := new(content)
 	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	err = s.checkEmpty(ctx, repo, err)
 	raw, berr := base64.StdEncoding.DecodeString(out.Content)
 	if berr != nil {
 		// samples in the gitlab documentation use RawStdEncoding
@@ -51,6 +52,108 @@ func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*s
 	return nil, scm.ErrNotSupported
 }
 
+// Tree returns the repository tree. GitLab returns the tree as
+// a paginated list, and all pages are requested.
+func (s *contentService) Tree(ctx context.Context, repo, ref string, recursive bool) ([]*scm.FileEntry, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("ref", ref)
+	if recursive {
+		params.Set("recursive", "true")
+	}
+	entries := []*scm.FileEntry{}
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		endpoint := fmt.Sprintf("api/v4/projects/%s/repository/tree?%s&%s", encode(repo), params.Encode(), encodeListOptions(opts))
+		out := []*treeEntry{}
+		res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+		if err != nil {
+			return nil, res, s.checkEmpty(ctx, repo, err)
+		}
+		entries = append(entries, convertTreeEntryList(out)...)
+		if res.Page.Next == 0 {
+			return entries, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return entries, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// BatchUpdate commits the file actions using the commits API,
+// which applies all actions in a single commit. GitLab keeps
+// the mode of updated files. A 100755 or 100644 mode is applied
+// with an additional chmod action, and other modes, such as
+// symlinks, are not supported.
+func (s *contentService) BatchUpdate(ctx context.Context, repo string, input *scm.BatchCommitInput) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits", encode(repo))
+	in := &batchCommitInput{
+		Branch:  input.Branch,
+		Message: input.Message,
+		Actions: []*fileAction{},
+	}
+	for _, v := range input.Actions {
+		action := &fileAction{
+			Action: v.Action,
+			Path:   v.Path,
+		}
+		if v.Action != "delete" {
+			action.Content = base64.StdEncoding.EncodeToString(v.Data)
+			action.Encoding = "base64"
+		}
+		in.Actions = append(in.Actions, action)
+		if v.Action == "delete" {
+			continue
+		}
+		switch v.Mode {
+		case "100755", "100644":
+			executable := v.Mode == "100755"
+			in.Actions = append(in.Actions, &fileAction{
+				Action:     "chmod",
+				Path:       v.Path,
+				Executable: &executable,
+			})
+		}
+	}
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCommit(out), res, err
+}
+
+// checkEmpty returns ErrEmptyRepository if the not found error
+// is caused by the repository having no commits. GitLab responds
+// to file and tree requests for empty repositories with 404 Not
+// Found, so the project is requested to tell the two apart.
+func (s *contentService) checkEmpty(ctx context.Context, repo string, err error) error {
+	if err != scm.ErrNotFound {
+		return err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	out := new(repository)
+	if _, perr := s.client.do(ctx, "GET", path, nil, out); perr != nil {
+		return err
+	}
+	if out.EmptyRepo {
+		return scm.ErrEmptyRepository
+	}
+	return err
+}
+
+type batchCommitInput struct {
+	Branch  string        `json:"branch"`
+	Message string        `json:"commit_message"`
+	Actions []*fileAction `json:"actions"`
+}
+
+type fileAction struct {
+	Action   string `json:"action"`
+	Path     string `json:"file_path"`
+	Content  string `json:"content,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+
+	Executable *bool `json:"execute_filemode,omitempty"`
+}
+
 type content struct {
 	FileName     string `json:"file_name"`
 	FilePath     string `json:"file_path"`
@@ -62,3 +165,43 @@ type content struct {
 	CommitID     string `json:"commit_id"`
 	LastCommitID string `json:"last_commit_id"`
 }
+
+type treeEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+func convertTreeEntryList(from []*treeEntry) []*scm.FileEntry {
+	to := []*scm.FileEntry{}
+	for _, v := range from {
+		to = append(to, convertTreeEntry(v))
+	}
+	return to
+}
+
+func convertTreeEntry(from *treeEntry) *scm.FileEntry {
+	return &scm.FileEntry{
+		Name: from.Name,
+		Path: from.Path,
+		Type: convertTreeEntryType(from.Type, from.Mode),
+		Sha:  from.ID,
+	}
+}
+
+// helper function returns the file entry type for the git
+// object type and file mode.
+func convertTreeEntryType(typ, mode string) string {
+	switch {
+	case typ == "tree":
+		return "dir"
+	case typ == "commit":
+		return "submodule"
+	case mode == "120000":
+		return "symlink"
+	default:
+		return "file"
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -52,6 +53,203 @@ func TestContentFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestContentBatchUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/commits").
+		BodyString(`"branch":"master","commit_message":"update configuration","actions":\[` +
+			`{"action":"update","file_path":"config.yaml","content":"aGVsbG8=","encoding":"base64"},` +
+			`{"action":"create","file_path":"bin/run","content":"aGVsbG8=","encoding":"base64"},` +
+			`{"action":"chmod","file_path":"bin/run","execute_filemode":true},` +
+			`{"action":"delete","file_path":"old.yaml"}\]`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	input := &scm.BatchCommitInput{
+		Branch:  "master",
+		Message: "update configuration",
+		Actions: []*scm.FileAction{
+			{Action: "update", Path: "config.yaml", Data: []byte("hello")},
+			{Action: "create", Path: "bin/run", Data: []byte("hello"), Mode: "100755"},
+			{Action: "delete", Path: "old.yaml"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Contents.BatchUpdate(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestContentFind_EmptyRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/files/README").
+		MatchParam("ref", "master").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"404 File Not Found"}`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo_empty.json")
+
+	client := NewDefault()
+	_, _, err := client.Contents.Find(context.Background(), "diaspora/diaspora", "README", "master")
+	if err != scm.ErrEmptyRepository {
+		t.Errorf("Expect Empty Repository error, got %v", err)
+	}
+}
+
+func TestContentFind_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/files/README").
+		MatchParam("ref", "master").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"404 File Not Found"}`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	_, _, err := client.Contents.Find(context.Background(), "diaspora/diaspora", "README", "master")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestContentTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("ref", "master").
+		MatchParam("recursive", "true").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://gitlab.com/resource?page=2>; rel="next"`).
+		File("testdata/tree.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("ref", "master").
+		MatchParam("recursive", "true").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	client := NewDefault()
+	got, res, err := client.Contents.Tree(context.Background(), "diaspora/diaspora", "master", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileEntry{}
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestContentTree_Cancel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://gitlab.com/resource?page=2>; rel="next"`).
+		File("testdata/tree.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	// the context is cancelled once the first page is received.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := NewDefault()
+	client.Client = &http.Client{
+		Transport: &cancelTransport{cancel: cancel},
+	}
+
+	got, _, err := client.Contents.Tree(ctx, "diaspora/diaspora", "master", true)
+	if err != context.Canceled {
+		t.Errorf("Expect context canceled error, got %v", err)
+	}
+
+	want := []*scm.FileEntry{}
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsDone() {
+		t.Errorf("Expect the second page not to be requested")
+	}
+}
+
+// cancelTransport cancels the context after each round trip.
+type cancelTransport struct {
+	cancel context.CancelFunc
+}
+
+func (t *cancelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultTransport.RoundTrip(req)
+	t.cancel()
+	return res, err
+}
+
 func TestContentCreate(t *testing.T) {
 	content := new(contentService)
 	_, err := content.Create(context.Background(), "octocat/hello-world", "README", nil)
@@ -0,0 +1,153 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type gistService struct {
+	client *wrapper
+}
+
+// maxSnippetSize is the maximum number of bytes read from the
+// raw content of a snippet.
+const maxSnippetSize = 10 << 20
+
+// Find returns the snippet by id. GitLab does not return the
+// file content with the snippet, so the raw content of the
+// primary snippet file is fetched separately. An error is
+// returned if the content is larger than maxSnippetSize.
+func (s *gistService) Find(ctx context.Context, id string) (*scm.Gist, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/snippets/%s", id)
+	out := new(snippet)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertSnippet(out)
+	if out.FileName == "" {
+		return to, res, nil
+	}
+	body, res, err := s.client.stream(ctx, fmt.Sprintf("api/v4/snippets/%s/raw", id))
+	if err != nil {
+		return nil, res, err
+	}
+	defer body.Close()
+	raw, err := ioutil.ReadAll(io.LimitReader(body, maxSnippetSize+1))
+	if err != nil {
+		return nil, res, err
+	}
+	if len(raw) > maxSnippetSize {
+		return nil, res, fmt.Errorf("snippet %s is larger than %d bytes", id, maxSnippetSize)
+	}
+	to.Files[out.FileName] = string(raw)
+	return to, res, nil
+}
+
+func (s *gistService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Gist, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/snippets?%s", encodeListOptions(opts))
+	out := []*snippet{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSnippetList(out), res, err
+}
+
+func (s *gistService) Create(ctx context.Context, input *scm.GistInput) (*scm.Gist, *scm.Response, error) {
+	path := "api/v4/snippets"
+	out := new(snippet)
+	res, err := s.client.do(ctx, "POST", path, convertSnippetInput(input), out)
+	return convertSnippet(out), res, err
+}
+
+func (s *gistService) Delete(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/snippets/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type snippet struct {
+	ID          int64          `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Visibility  string         `json:"visibility"`
+	FileName    string         `json:"file_name"`
+	Files       []*snippetFile `json:"files"`
+}
+
+type snippetFile struct {
+	Path string `json:"path"`
+}
+
+type snippetInput struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Visibility  string              `json:"visibility"`
+	Files       []*snippetFileInput `json:"files"`
+}
+
+type snippetFileInput struct {
+	FilePath string `json:"file_path"`
+	Content  string `json:"content"`
+}
+
+// helper function to convert the snippet input to the json
+// request body. The files are sorted by path so that the
+// request is stable.
+func convertSnippetInput(input *scm.GistInput) *snippetInput {
+	to := &snippetInput{
+		Title:       input.Description,
+		Description: input.Description,
+		Visibility:  "private",
+		Files:       []*snippetFileInput{},
+	}
+	if input.Public {
+		to.Visibility = "public"
+	}
+	names := []string{}
+	for name := range input.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		to.Files = append(to.Files, &snippetFileInput{
+			FilePath: name,
+			Content:  input.Files[name],
+		})
+	}
+	return to
+}
+
+func convertSnippetList(from []*snippet) []*scm.Gist {
+	to := []*scm.Gist{}
+	for _, v := range from {
+		to = append(to, convertSnippet(v))
+	}
+	return to
+}
+
+func convertSnippet(from *snippet) *scm.Gist {
+	to := &scm.Gist{
+		ID:          strconv.FormatInt(from.ID, 10),
+		Description: from.Description,
+		Public:      from.Visibility == "public",
+		Files:       map[string]string{},
+	}
+	if to.Description == "" {
+		to.Description = from.Title
+	}
+	for _, file := range from.Files {
+		to.Files[file.Path] = ""
+	}
+	if from.FileName != "" {
+		to.Files[from.FileName] = ""
+	}
+	return to
+}
@@ -0,0 +1,223 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestGistFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/snippets/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/snippet.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/snippets/1/raw").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/snippet_raw.txt")
+
+	client := NewDefault()
+	got, res, err := client.Gists.Find(context.Background(), "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Gist)
+	raw, _ := ioutil.ReadFile("testdata/snippet.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGistFind_TooLarge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/snippets/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/snippet.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/snippets/1/raw").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		BodyString(strings.Repeat("x", maxSnippetSize+1))
+
+	client := NewDefault()
+	_, _, err := client.Gists.Find(context.Background(), "1")
+	if err == nil {
+		t.Errorf("Expect error when the snippet content is too large")
+	}
+}
+
+func TestGistList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/snippets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/snippets.json")
+
+	client := NewDefault()
+	got, res, err := client.Gists.List(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Gist{}
+	raw, _ := ioutil.ReadFile("testdata/snippets.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestGistList_LargeID(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/snippets").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"id":4294967297,"title":"notes","visibility":"private"}]`)
+
+	client := NewDefault()
+	got, _, err := client.Gists.List(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 || got[0].ID != "4294967297" {
+		t.Errorf("Want snippet id %q, got %v", "4294967297", got)
+	}
+}
+
+func TestGistCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/snippets").
+		JSON(&snippetInput{
+			Title:       "shared pipeline config",
+			Description: "shared pipeline config",
+			Visibility:  "private",
+			Files: []*snippetFileInput{
+				{FilePath: "config.yaml", Content: "debug: true\n"},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/snippet.json")
+
+	input := &scm.GistInput{
+		Description: "shared pipeline config",
+		Files: map[string]string{
+			"config.yaml": "debug: true\n",
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Gists.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Gist)
+	raw, _ := ioutil.ReadFile("testdata/snippet_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGistDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/snippets/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Gists.Delete(context.Background(), "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestConvertSnippetInput(t *testing.T) {
+	input := &scm.GistInput{
+		Description: "notes",
+		Public:      true,
+		Files: map[string]string{
+			"b.md": "second",
+			"a.md": "first",
+		},
+	}
+	want := &snippetInput{
+		Title:       "notes",
+		Description: "notes",
+		Visibility:  "public",
+		Files: []*snippetFileInput{
+			{FilePath: "a.md", Content: "first"},
+			{FilePath: "b.md", Content: "second"},
+		},
+	}
+	if diff := cmp.Diff(convertSnippetInput(input), want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -5,12 +5,15 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/diff"
 )
 
 type gitService struct {
@@ -44,6 +47,38 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	panic("implement me")
 }
 
+// IsAncestor compares the descendant against the ancestor. The
+// comparison lists the ancestor commits missing from the
+// descendant, which is empty when ancestor is an ancestor.
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("from", descendant)
+	params.Set("to", ancestor)
+	path := fmt.Sprintf("api/v4/projects/%s/repository/compare?%s", encode(repo), params.Encode())
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return len(out.Commits) == 0, res, nil
+}
+
+func (s *gitService) CherryPick(ctx context.Context, repo, sha, branch string) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/cherry_pick", encode(repo), sha)
+	in := &commitActionInput{Branch: branch}
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCommit(out), res, err
+}
+
+func (s *gitService) Revert(ctx context.Context, repo, sha, branch string) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/revert", encode(repo), sha)
+	in := &commitActionInput{Branch: branch}
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCommit(out), res, err
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), name)
 	out := new(branch)
@@ -58,6 +93,35 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+// FindDiff returns the unified diff of the commit. GitLab returns
+// the commit diff as a paginated list of file diffs, which are
+// joined into a single patch with git diff headers.
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	buf := new(bytes.Buffer)
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/diff?%s", encode(repo), ref, encodeListOptions(opts))
+		out := []*commitDiff{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			writeCommitDiff(buf, v)
+		}
+		if buf.Len() > diff.MaxSize {
+			return nil, res, scm.ErrDiffTooLarge
+		}
+		if res.Page.Next == 0 {
+			return buf.Bytes(), res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return buf.Bytes(), res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), name)
 	out := new(branch)
@@ -98,6 +162,23 @@ type branch struct {
 	Commit struct {
 		ID string `json:"id"`
 	}
+	Protected bool `json:"protected"`
+}
+
+type commitDiff struct {
+	OldPath string `json:"old_path"`
+	NewPath string `json:"new_path"`
+	Added   bool   `json:"new_file"`
+	Deleted bool   `json:"deleted_file"`
+	Diff    string `json:"diff"`
+}
+
+type commitActionInput struct {
+	Branch string `json:"branch"`
+}
+
+type compare struct {
+	Commits []*commit `json:"commits"`
 }
 
 type commit struct {
@@ -150,9 +231,10 @@ func convertBranchList(from []*branch) []*scm.Reference {
 
 func convertBranch(from *branch) *scm.Reference {
 	return &scm.Reference{
-		Name: scm.TrimRef(from.Name),
-		Path: scm.ExpandRef(from.Name, "refs/heads/"),
-		Sha:  from.Commit.ID,
+		Name:      scm.TrimRef(from.Name),
+		Path:      scm.ExpandRef(from.Name, "refs/heads/"),
+		Sha:       from.Commit.ID,
+		Protected: from.Protected,
 	}
 }
 
@@ -171,3 +253,21 @@ func convertTag(from *branch) *scm.Reference {
 		Sha:  from.Commit.ID,
 	}
 }
+
+// helper function to write the gitlab commit file diff to
+// the buffer as a unified diff with git headers.
+func writeCommitDiff(buf *bytes.Buffer, from *commitDiff) {
+	oldPath, newPath := "a/"+from.OldPath, "b/"+from.NewPath
+	if from.Added {
+		oldPath = "/dev/null"
+	}
+	if from.Deleted {
+		newPath = "/dev/null"
+	}
+	fmt.Fprintf(buf, "diff --git a/%s b/%s\n", from.OldPath, from.NewPath)
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", oldPath, newPath)
+	buf.WriteString(from.Diff)
+	if !strings.HasSuffix(from.Diff, "\n") {
+		buf.WriteString("\n")
+	}
+}
@@ -6,37 +6,56 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
-	"net/url"
+	"io"
 	"strconv"
-	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/baseurl"
 )
 
 // New returns a new GitLab API client.
 func New(uri string) (*scm.Client, error) {
-	base, err := url.Parse(uri)
+	base, err := baseurl.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(base.Path, "/") {
-		base.Path = base.Path + "/"
-	}
 	client := &wrapper{new(scm.Client)}
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGitlab
 	client.Contents = &contentService{client}
+	client.Gists = &gistService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Misc = &miscService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Rulesets = &rulesetService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.SetDoFunc(client.do)
+	client.SetRateLimitFunc(client.rateLimit)
+	client.SetCapabilities(scm.Capabilities{
+		Issues:        true,
+		IssueLocking:  true,
+		LinkedIssues:  true,
+		Rebase:        true,
+		ReviewThreads: true,
+		Statuses:      true,
+		Collaborators: true,
+		Contributors:  true,
+		Gists:         true,
+		Stars:         true,
+		Archives:      true,
+		Pipelines:     true,
+	})
 	return client.Client, nil
 }
 
@@ -60,7 +79,21 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		Method: method,
 		Path:   path,
 	}
+	// most endpoints take their parameters in the query
+	// string, but some require a json request body.
+	if in != nil {
+		buf := new(bytes.Buffer)
+		json.NewEncoder(buf).Encode(in)
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = buf
+	}
+	return c.send(ctx, req, out)
+}
 
+// send executes the http request and unmarshals the response.
+func (c *wrapper) send(ctx context.Context, req *scm.Request, out interface{}) (*scm.Response, error) {
 	// execute the http request
 	res, err := c.Client.Do(ctx, req)
 	if err != nil {
@@ -68,6 +101,100 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	c.parseResponse(res)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status == 404 {
+		return res, scm.ErrNotFound
+	} else if res.Status > 300 {
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		return res, err
+	}
+
+	if out == nil {
+		return res, nil
+	}
+
+	// if a json response is expected, parse and return
+	// the json response.
+	return res, json.NewDecoder(res.Body).Decode(out)
+}
+
+// graphql sends a GraphQL query to the GitLab API and
+// unmarshals the data field of the response into out.
+func (c *wrapper) graphql(ctx context.Context, query string, vars map[string]interface{}, out interface{}) (*scm.Response, error) {
+	in := &graphqlRequest{
+		Query:     query,
+		Variables: vars,
+	}
+	wrapped := &graphqlResponse{Data: out}
+	res, err := c.do(ctx, "POST", "api/graphql", in, wrapped)
+	if err != nil {
+		return res, err
+	}
+	if len(wrapped.Errors) != 0 {
+		return res, &Error{Message: wrapped.Errors[0].Message}
+	}
+	return res, nil
+}
+
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// rateLimit returns the rate limit reported by the response
+// headers of the version endpoint, which is the cheapest call
+// available. GitLab does not provide a dedicated endpoint, so
+// the call counts against the rate limit.
+func (c *wrapper) rateLimit(ctx context.Context) (*scm.Rate, *scm.Response, error) {
+	res, err := c.do(ctx, "GET", "api/v4/version", nil, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	rate := res.Rate
+	return &rate, res, nil
+}
+
+// stream executes a GET request and returns the response body
+// without buffering it. The caller is responsible for closing
+// the returned body.
+func (c *wrapper) stream(ctx context.Context, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+		Stream: true,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.parseResponse(res)
+
+	if res.Status == 404 {
+		res.Body.Close()
+		return nil, res, scm.ErrNotFound
+	} else if res.Status > 300 {
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		res.Body.Close()
+		return nil, res, err
+	}
+	return res.Body, res, nil
+}
+
+// parseResponse parses the request id and rate limit details
+// from the response headers.
+func (c *wrapper) parseResponse(res *scm.Response) {
 	// parse the gitlab request id.
 	res.ID = res.Header.Get("X-Request-Id")
 
@@ -84,22 +211,6 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 
 	// snapshot the request rate limit
 	c.Client.SetRate(res.Rate)
-
-	// if an error is encountered, unmarshal and return the
-	// error response.
-	if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
-	}
-
-	if out == nil {
-		return res, nil
-	}
-
-	// if a json response is expected, parse and return
-	// the json response.
-	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
 // Error represents a GitLab error.
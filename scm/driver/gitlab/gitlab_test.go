@@ -5,8 +5,11 @@
 package gitlab
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 	"github.com/jenkins-x/go-scm/scm"
 )
 
@@ -53,6 +56,34 @@ func TestClient_Default(t *testing.T) {
 	}
 }
 
+func TestClient_Capabilities(t *testing.T) {
+	want := scm.Capabilities{
+		Issues:        true,
+		IssueLocking:  true,
+		LinkedIssues:  true,
+		Rebase:        true,
+		ReviewThreads: true,
+		Statuses:      true,
+		Collaborators: true,
+		Contributors:  true,
+		Gists:         true,
+		Stars:         true,
+		Archives:      true,
+		Pipelines:     true,
+	}
+	if diff := cmp.Diff(NewDefault().Capabilities(), want); diff != "" {
+		t.Errorf("Unexpected Capabilities")
+		t.Log(diff)
+	}
+}
+
+func TestClient_NoScheme(t *testing.T) {
+	_, err := New("gitlab.com")
+	if err == nil {
+		t.Errorf("Expect error when URL has no scheme")
+	}
+}
+
 func TestClient_Error(t *testing.T) {
 	_, err := New("http://a b.com/")
 	if err == nil {
@@ -98,3 +129,29 @@ func testRequest(res *scm.Response) func(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_RateLimit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/version").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"version":"16.3.0","revision":"1a2b3c4d"}`)
+
+	client := NewDefault()
+	got, res, err := client.RateLimit(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Rate{Limit: 600, Remaining: 599, Reset: 1512454441}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
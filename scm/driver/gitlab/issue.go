@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -26,6 +28,21 @@ func (s *issueService) UnassignIssue(ctx context.Context, repo string, number in
 	panic("implement me")
 }
 
+// SetAssignees replaces the issue assignees. The logins are
+// resolved to user ids first, and the issue is not updated if
+// any login cannot be resolved.
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Issue, *scm.Response, error) {
+	ids, err := (&repositoryService{s.client}).findUserIDs(ctx, logins, "assign")
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	in := map[string][]int64{"assignee_ids": ids}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) ListEvents(context.Context, string, int, scm.ListOptions) ([]*scm.ListedIssueEvent, *scm.Response, error) {
 	panic("implement me")
 }
@@ -71,10 +88,42 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 	return convertIssueCommentList(out), res, err
 }
 
+// ListAllComments returns the issue notes, listing all pages.
+// Issues and merge requests are numbered separately in GitLab,
+// and issues have no inline review comments.
+func (s *issueService) ListAllComments(ctx context.Context, repo string, number int) ([]*scm.Comment, *scm.Response, error) {
+	all := []*scm.Comment{}
+	opts := scm.ListOptions{Page: 1, Size: maxPageSize}
+	for {
+		comments, res, err := s.ListComments(ctx, repo, number, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, comments...)
+		if res.Page.Next == 0 {
+			scm.SortComments(all)
+			return all, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			scm.SortComments(all)
+			return all, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// Create creates a new issue. The assignees are ignored, as
+// GitLab expects user ids rather than logins.
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	in := url.Values{}
 	in.Set("title", input.Title)
 	in.Set("description", input.Body)
+	if input.Milestone != 0 {
+		in.Set("milestone_id", strconv.Itoa(input.Milestone))
+	}
+	if len(input.Labels) != 0 {
+		in.Set("labels", strings.Join(input.Labels, ","))
+	}
 	path := fmt.Sprintf("api/v4/projects/%s/issues?%s", encode(repo), in.Encode())
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, nil, out)
@@ -101,18 +150,34 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?state_event=reopen", encode(repo), number)
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?discussion_locked=true", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
 	return res, err
 }
 
+// LockWithOptions locks the issue discussion. GitLab does not
+// support a lock reason, so the reason is ignored.
+func (s *issueService) LockWithOptions(ctx context.Context, repo string, number int, _ scm.LockOptions) (*scm.Response, error) {
+	return s.Lock(ctx, repo, number)
+}
+
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?discussion_locked=false", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
 	return res, err
 }
 
+func (s *issueService) Transfer(ctx context.Context, repo string, number int, targetRepo string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type issue struct {
 	ID     int      `json:"id"`
 	Number int      `json:"iid"`
@@ -127,8 +192,9 @@ type issue struct {
 		Username string      `json:"username"`
 		Avatar   null.String `json:"avatar_url"`
 	} `json:"author"`
-	Created time.Time `json:"created_at"`
-	Updated time.Time `json:"updated_at"`
+	Assignees []*user   `json:"assignees"`
+	Created   time.Time `json:"created_at"`
+	Updated   time.Time `json:"updated_at"`
 }
 
 type issueComment struct {
@@ -174,8 +240,9 @@ func convertIssue(from *issue) *scm.Issue {
 			Login:  from.Author.Username,
 			Avatar: from.Author.Avatar.String,
 		},
-		Created: from.Created,
-		Updated: from.Updated,
+		Assignees: convertUserList(from.Assignees),
+		Created:   from.Created,
+		Updated:   from.Updated,
 	}
 }
 
@@ -7,7 +7,9 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -111,6 +113,27 @@ func TestIssueList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestIssueList_Filters(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/issues").
+		MatchParam("assignee_username", "octocat").
+		MatchParam("author_username", "hubot").
+		MatchParam("labels", "bug,ui").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issues.json")
+
+	client := NewDefault()
+	opts := scm.IssueListOptions{Assignee: "octocat", Creator: "hubot", Labels: []string{"bug", "ui"}}
+	_, _, err := client.Issues.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueListComments(t *testing.T) {
 	defer gock.Off()
 
@@ -145,6 +168,46 @@ func TestIssueListComments(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestIssueListAllComments_Cancel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/issues/1/notes").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://gitlab.com/resource?page=2>; rel="next"`).
+		File("testdata/issue_notes.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/issues/1/notes").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	// the context is cancelled once the first page is received.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := NewDefault()
+	client.Client = &http.Client{
+		Transport: &cancelTransport{cancel: cancel},
+	}
+
+	got, _, err := client.Issues.ListAllComments(ctx, "diaspora/diaspora", 1)
+	if err != context.Canceled {
+		t.Errorf("Expect context canceled error, got %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("Expect the comments of the first page, got %d", len(got))
+	}
+	if gock.IsDone() {
+		t.Errorf("Expect the second page not to be requested")
+	}
+}
+
 func TestIssueCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -180,6 +243,136 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueCreate_Milestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/issues").
+		MatchParam("milestone_id", "1").
+		MatchParam("labels", "bug,critical").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Found a bug",
+		Body:      "I'm having a problem with this.",
+		Milestone: 1,
+		Labels:    []string{"bug", "critical"},
+	}
+
+	client := NewDefault()
+	_, _, err := client.Issues.Create(context.Background(), "diaspora/diaspora", &input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/issues/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/users_username.json")
+
+	// the assignee ids replace the current assignees, so any
+	// other assignee is removed in the same call.
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1").
+		JSON(map[string][]int64{"assignee_ids": {1}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_assignees.json")
+
+	client := NewDefault()
+	before, _, err := client.Issues.Find(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if diff := cmp.Diff(issueAssignees(before), []string{"lennie"}); diff != "" {
+		t.Errorf("Unexpected previous assignees")
+		t.Log(diff)
+	}
+
+	got, res, err := client.Issues.SetAssignees(context.Background(), "diaspora/diaspora", 1, []string{"john_smith"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if diff := cmp.Diff(issueAssignees(got), []string{"john_smith"}); diff != "" {
+		t.Errorf("Unexpected assignees")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueSetAssignees_Missing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/users_username.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "jane_doe").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	// the issue is not updated, so no PUT request is mocked.
+	client := NewDefault()
+	_, _, err := client.Issues.SetAssignees(context.Background(), "diaspora/diaspora", 1, []string{"john_smith", "jane_doe"})
+
+	var missing scm.MissingUsers
+	if !errors.As(err, &missing) {
+		t.Errorf("Want scm.MissingUsers error, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(missing.Users, []string{"jane_doe"}); diff != "" {
+		t.Errorf("Unexpected missing users")
+		t.Log(diff)
+	}
+}
+
+func TestIssueSetAssignees_None(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1").
+		JSON(map[string][]int64{"assignee_ids": {}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	if _, _, err := client.Issues.SetAssignees(context.Background(), "diaspora/diaspora", 1, nil); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -256,6 +449,27 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1").
+		MatchParam("state_event", "reopen").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	defer gock.Off()
 
@@ -277,6 +491,27 @@ func TestIssueLock(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueLockWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1").
+		MatchParam("discussion_locked", "true").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.LockWithOptions(context.Background(), "diaspora/diaspora", 1, scm.LockOptions{Reason: scm.LockReasonSpam})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueUnlock(t *testing.T) {
 	defer gock.Off()
 
@@ -297,3 +532,12 @@ func TestIssueUnlock(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+// issueAssignees returns the logins of the issue assignees.
+func issueAssignees(issue *scm.Issue) []string {
+	logins := []string{}
+	for _, v := range issue.Assignees {
+		logins = append(logins, v.Login)
+	}
+	return logins
+}
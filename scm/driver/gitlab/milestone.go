@@ -0,0 +1,170 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type milestoneService struct {
+	client *wrapper
+}
+
+// List returns the project milestones. GitLab does not include
+// the issue counts in the milestone resource, so the counts of
+// the page of milestones are requested with a single GraphQL
+// query. The milestones are sorted by due date within the page,
+// as GitLab does not support sorting milestones.
+func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
+	if opts.Sort != "" && opts.Sort != "due_on" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/milestones?%s", encode(repo), encodeMilestoneListOptions(opts))
+	out := []*milestone{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertMilestoneList(out)
+	if err := s.countIssues(ctx, repo, to); err != nil {
+		return nil, res, err
+	}
+	if opts.Sort == "due_on" {
+		sortMilestones(to, opts.Direction == "desc")
+	}
+	return to, res, nil
+}
+
+// helper function populates the open and closed issue counts of
+// the milestones from the milestone statistics.
+func (s *milestoneService) countIssues(ctx context.Context, repo string, milestones []*scm.Milestone) error {
+	if len(milestones) == 0 {
+		return nil
+	}
+	ids := []string{}
+	for _, v := range milestones {
+		ids = append(ids, milestoneGlobalID(v.ID))
+	}
+	out := new(milestoneStats)
+	_, err := s.client.graphql(ctx, milestoneStatsQuery, map[string]interface{}{
+		"fullPath": repo,
+		"ids":      ids,
+	}, out)
+	if err != nil {
+		return err
+	}
+	stats := map[string]milestoneStatsNode{}
+	for _, v := range out.Project.Milestones.Nodes {
+		stats[v.ID] = v
+	}
+	for _, v := range milestones {
+		if node, ok := stats[milestoneGlobalID(v.ID)]; ok {
+			v.ClosedIssues = node.Stats.ClosedIssuesCount
+			v.OpenIssues = node.Stats.TotalIssuesCount - node.Stats.ClosedIssuesCount
+		}
+	}
+	return nil
+}
+
+// helper function returns the GraphQL global id of the milestone.
+func milestoneGlobalID(id int) string {
+	return fmt.Sprintf("gid://gitlab/Milestone/%d", id)
+}
+
+// helper function sorts the milestones by due date, placing the
+// milestones without a due date last.
+func sortMilestones(milestones []*scm.Milestone, desc bool) {
+	sort.SliceStable(milestones, func(i, j int) bool {
+		a, b := milestones[i].DueDate, milestones[j].DueDate
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero()
+		}
+		if desc {
+			return a.After(b)
+		}
+		return a.Before(b)
+	})
+}
+
+type milestone struct {
+	ID          int       `json:"id"`
+	IID         int       `json:"iid"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	State       string    `json:"state"`
+	WebURL      string    `json:"web_url"`
+	DueDate     string    `json:"due_date"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+const milestoneStatsQuery = `query($fullPath: ID!, $ids: [ID!]) {
+  project(fullPath: $fullPath) {
+    milestones(ids: $ids, first: 100) {
+      nodes {
+        id
+        stats {
+          totalIssuesCount
+          closedIssuesCount
+        }
+      }
+    }
+  }
+}`
+
+type milestoneStats struct {
+	Project struct {
+		Milestones struct {
+			Nodes []milestoneStatsNode `json:"nodes"`
+		} `json:"milestones"`
+	} `json:"project"`
+}
+
+type milestoneStatsNode struct {
+	ID    string `json:"id"`
+	Stats struct {
+		TotalIssuesCount  int `json:"totalIssuesCount"`
+		ClosedIssuesCount int `json:"closedIssuesCount"`
+	} `json:"stats"`
+}
+
+func convertMilestoneList(from []*milestone) []*scm.Milestone {
+	to := []*scm.Milestone{}
+	for _, v := range from {
+		to = append(to, convertMilestone(v))
+	}
+	return to
+}
+
+func convertMilestone(from *milestone) *scm.Milestone {
+	to := &scm.Milestone{
+		ID:          from.ID,
+		Number:      from.IID,
+		Title:       from.Title,
+		Description: from.Description,
+		State:       convertMilestoneState(from.State),
+		Link:        from.WebURL,
+		Created:     from.CreatedAt,
+		Updated:     from.UpdatedAt,
+	}
+	if from.DueDate != "" {
+		to.DueDate, _ = time.Parse("2006-01-02", from.DueDate)
+	}
+	return to
+}
+
+func convertMilestoneState(from string) string {
+	switch from {
+	case "active":
+		return "open"
+	default:
+		return from
+	}
+}
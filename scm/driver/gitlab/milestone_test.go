@@ -0,0 +1,74 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestMilestoneList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/milestones").
+		MatchParam("state", "active").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/milestones.json")
+
+	// the issue counts of the page are requested in one query.
+	gock.New("https://gitlab.com").
+		Post("/api/graphql").
+		File("testdata/milestone_stats_query.json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/milestone_stats.json")
+
+	opts := scm.MilestoneListOptions{Page: 1, Size: 30, State: "open", Sort: "due_on"}
+	client := NewDefault()
+	got, res, err := client.Milestones.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Milestone{}
+	raw, _ := ioutil.ReadFile("testdata/milestones.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestMilestoneList_SortNotSupported(t *testing.T) {
+	opts := scm.MilestoneListOptions{Sort: "completeness"}
+	_, _, err := NewDefault().Milestones.List(context.Background(), "diaspora/diaspora", opts)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
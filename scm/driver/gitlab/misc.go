@@ -0,0 +1,45 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type miscService struct {
+	client *wrapper
+}
+
+// RenderMarkdown renders the markdown text to HTML. The text is
+// rendered as GitLab Flavored Markdown in the project context,
+// when the project is provided.
+//
+// See https://docs.gitlab.com/ee/api/markdown.html
+func (s *miscService) RenderMarkdown(ctx context.Context, repo, text string) (string, *scm.Response, error) {
+	in := &markdownInput{
+		Text:    text,
+		GFM:     repo != "",
+		Project: repo,
+	}
+	out := new(markdown)
+	res, err := s.client.do(ctx, "POST", "api/v4/markdown", in, out)
+	return out.HTML, res, err
+}
+
+func (s *miscService) ListEmojis(ctx context.Context) (map[string]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type markdownInput struct {
+	Text    string `json:"text"`
+	GFM     bool   `json:"gfm"`
+	Project string `json:"project,omitempty"`
+}
+
+type markdown struct {
+	HTML string `json:"html"`
+}
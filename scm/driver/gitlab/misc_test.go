@@ -0,0 +1,39 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestMiscRenderMarkdown(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/markdown").
+		BodyString(`{"text":"Fixes #1","gfm":true,"project":"diaspora/diaspora"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/markdown.json")
+
+	client := NewDefault()
+	got, res, err := client.Misc.RenderMarkdown(context.Background(), "diaspora/diaspora", "Fixes #1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !strings.HasPrefix(got, `<p data-sourcepos="1:1-1:9" dir="auto">Fixes <a href="/diaspora/diaspora/-/issues/1"`) {
+		t.Errorf("Unexpected html %q", got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -32,24 +32,22 @@ func (s *organizationService) IsMember(ctx context.Context, org string, user str
 }
 
 func (s *organizationService) ListTeams(ctx context.Context, org string, ops scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
-	// TODO implement me
-	return nil, nil, nil
+	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *organizationService) ListTeamMembers(ctx context.Context, id int, role string, ops scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
-	// TODO implement me
-	return nil, nil, nil
+	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *organizationService) ListMemberUsers(ctx context.Context, org string) ([]scm.User, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/members/all", org)
+	path := fmt.Sprintf("api/v4/projects/%s/members/all", encode(org))
 	out := []*user{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertUserList(out), res, err
 }
 
 func (s *organizationService) Find(ctx context.Context, name string) (*scm.Organization, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/groups/%s", name)
+	path := fmt.Sprintf("api/v4/groups/%s", encode(name))
 	out := new(organization)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	return convertOrganization(out), res, err
@@ -82,3 +80,7 @@ func convertOrganization(from *organization) *scm.Organization {
 		Avatar: from.Avatar.String,
 	}
 }
+
+func (s *organizationService) ListAllHooks(context.Context, string) (map[string][]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
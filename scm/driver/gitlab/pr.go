@@ -6,11 +6,23 @@ package gitlab
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/diff"
+)
+
+// rebasePollInterval and rebaseTimeout control how often,
+// and for how long, the rebase status of a merge request is
+// polled.
+var (
+	rebasePollInterval = time.Second
+	rebaseTimeout      = 5 * time.Minute
 )
 
 type pullService struct {
@@ -31,6 +43,20 @@ func (s *pullService) FindComment(ctx context.Context, repo string, index, id in
 	return convertIssueComment(out), res, err
 }
 
+// FindDiff returns the raw unified diff of the merge request. The
+// raw_diffs endpoint returns the same patch as the merge request
+// .diff page, but it is served by the API, so it uses the client
+// base URL and token rather than a web session.
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) ([]byte, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/raw_diffs", encode(repo), number)
+	body, res, err := s.client.stream(ctx, path)
+	if err != nil {
+		return nil, res, err
+	}
+	out, err := diff.Read(body)
+	return out, res, err
+}
+
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests?%s", encode(repo), encodePullRequestListOptions(opts))
 	out := []*pr{}
@@ -38,6 +64,66 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequestList(out), res, err
 }
 
+// Create creates a new merge request. The assignees and
+// reviewers are resolved to user ids and set in the create
+// request; logins that cannot be resolved are skipped and
+// reported in the returned error.
+func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	users := &repositoryService{s.client}
+	var errs []error
+	assignees, err := users.findUserIDs(ctx, input.Assignees, "assign")
+	if err != nil {
+		errs = append(errs, err)
+	}
+	reviewers, err := users.findUserIDs(ctx, input.Reviewers, "request a review from")
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests", encode(repo))
+	in := &prInput{
+		Title:        input.Title,
+		Description:  input.Body,
+		SourceBranch: input.Source,
+		TargetBranch: input.Target,
+		AssigneeIDs:  assignees,
+		ReviewerIDs:  reviewers,
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertPullRequest(out), res, errors.Join(errs...)
+}
+
+// ListMine returns the open merge requests created by the
+// authenticated user across all projects.
+func (s *pullService) ListMine(ctx context.Context, opts scm.ListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("scope", "created_by_me")
+	params.Set("state", "opened")
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
+	}
+	path := fmt.Sprintf("api/v4/merge_requests?%s", params.Encode())
+	out := []*pr{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertScopedPullRequestList(out), res, err
+}
+
+// ListForCommit returns the merge requests associated with the
+// commit.
+func (s *pullService) ListForCommit(ctx context.Context, repo, sha string) ([]*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/merge_requests", encode(repo), sha)
+	out := []*pr{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPullRequestList(out), res, err
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/changes?%s", encode(repo), number, encodeListOptions(opts))
 	out := new(changes)
@@ -73,12 +159,89 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+// Rebase rebases the merge request source branch onto the
+// target branch. GitLab rebases asynchronously, so the merge
+// request is polled until the rebase is no longer in progress.
+func (s *pullService) Rebase(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/rebase", encode(repo), number)
+	out := new(rebaseStatus)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?include_rebase_in_progress=true", encode(repo), number)
+	timeout := time.After(rebaseTimeout)
+	for out.InProgress {
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-timeout:
+			return res, fmt.Errorf("timed out waiting for merge request %d to rebase", number)
+		case <-time.After(rebasePollInterval):
+		}
+		out = new(rebaseStatus)
+		res, err = s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return res, err
+		}
+	}
+	if out.MergeError != "" {
+		return res, &Error{Message: out.MergeError}
+	}
+	return res, nil
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?state_event=closed", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
 	return res, err
 }
 
+// FindCombinedStatus returns the combined status of the pull
+// request head commit.
+func (s *pullService) FindCombinedStatus(ctx context.Context, repo string, number int) (*scm.CombinedStatus, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.client.Repositories.FindCombinedStatus(ctx, repo, pr.Sha)
+}
+
+// ListLinkedIssues returns the issues that are closed when the
+// merge request is merged.
+func (s *pullService) ListLinkedIssues(ctx context.Context, repo string, number int) ([]*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/closes_issues", encode(repo), number)
+	out := []*issue{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertIssueList(out), res, err
+}
+
+// ApprovalCount returns the number of users approving the merge
+// request and the number of approvals its approval rules require.
+//
+// See https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-configuration-1
+func (s *pullService) ApprovalCount(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Approvals{
+		Approved: len(out.ApprovedBy),
+		Required: out.ApprovalsRequired,
+	}, res, nil
+}
+
+type prInput struct {
+	Title        string  `json:"title"`
+	Description  string  `json:"description,omitempty"`
+	SourceBranch string  `json:"source_branch"`
+	TargetBranch string  `json:"target_branch"`
+	AssigneeIDs  []int64 `json:"assignee_ids,omitempty"`
+	ReviewerIDs  []int64 `json:"reviewer_ids,omitempty"`
+}
+
 type pr struct {
 	Number int    `json:"iid"`
 	Sha    string `json:"sha"`
@@ -92,11 +255,33 @@ type pr struct {
 		Name     string `json:"name"`
 		Avatar   string `json:"avatar_url"`
 	}
-	SourceBranch string    `json:"source_branch"`
-	TargetBranch string    `json:"target_branch"`
-	Created      time.Time `json:"created_at"`
-	Updated      time.Time `json:"updated_at"`
-	Closed       time.Time
+	SourceBranch string   `json:"source_branch"`
+	TargetBranch string   `json:"target_branch"`
+	DiffRefs     diffRefs `json:"diff_refs"`
+	References   struct {
+		Full string `json:"full"`
+	} `json:"references"`
+	Created time.Time `json:"created_at"`
+	Updated time.Time `json:"updated_at"`
+	Closed  time.Time
+}
+
+type approvals struct {
+	ApprovalsRequired int `json:"approvals_required"`
+	ApprovedBy        []struct {
+		User user `json:"user"`
+	} `json:"approved_by"`
+}
+
+type rebaseStatus struct {
+	InProgress bool   `json:"rebase_in_progress"`
+	MergeError string `json:"merge_error"`
+}
+
+type diffRefs struct {
+	BaseSha  string `json:"base_sha"`
+	HeadSha  string `json:"head_sha"`
+	StartSha string `json:"start_sha"`
 }
 
 type changes struct {
@@ -141,6 +326,31 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 	}
 }
 
+// helper function to convert the merge requests listed across
+// projects, populating the project from the full reference, in
+// the namespace/name!iid format.
+func convertScopedPullRequestList(from []*pr) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		item := convertPullRequest(v)
+		full := v.References.Full
+		if i := strings.LastIndex(full, "!"); i != -1 {
+			full = full[:i]
+		}
+		namespace, name := scm.SplitFull(full)
+		item.Base = scm.PullRequestBranch{
+			Ref: v.TargetBranch,
+			Repo: scm.Repository{
+				Namespace: namespace,
+				Name:      name,
+				FullName:  full,
+			},
+		}
+		to = append(to, item)
+	}
+	return to
+}
+
 func convertChangeList(from []*change) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from {
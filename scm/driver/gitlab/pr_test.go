@@ -7,8 +7,10 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
@@ -80,6 +82,172 @@ func TestPullList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestPullListForCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/12d65c8dd2b2676fa3ac47d955accc085a37a9c1/merge_requests").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merges.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListForCommit(context.Background(), "diaspora/diaspora", "12d65c8dd2b2676fa3ac47d955accc085a37a9c1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/merges.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/users_username.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "jane_doe").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests").
+		BodyString(`"source_branch":"fix".*"target_branch":"master".*"assignee_ids":\[1\],"reviewer_ids":\[1\]`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	input := &scm.PullRequestInput{
+		Title:     "JS fix",
+		Body:      "Signed-off-by: Dmitriy Zaporozhets <dmitriy.zaporozhets@gmail.com>",
+		Source:    "fix",
+		Target:    "master",
+		Assignees: []string{"john_smith"},
+		Reviewers: []string{"john_smith", "jane_doe"},
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Create(context.Background(), "diaspora/diaspora", input)
+
+	var missing scm.MissingUsers
+	if !errors.As(err, &missing) {
+		t.Errorf("Want scm.MissingUsers error, got %v", err)
+	} else if diff := cmp.Diff(missing.Users, []string{"jane_doe"}); diff != "" {
+		t.Errorf("Unexpected missing users")
+		t.Log(diff)
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/merge.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullListMine(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/merge_requests").
+		MatchParam("scope", "created_by_me").
+		MatchParam("state", "opened").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/merges_mine.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListMine(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/merges_mine.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestPullList_Base(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests").
+		MatchParam("state", "opened").
+		MatchParam("target_branch", "master").
+		MatchParam("source_branch", "feature").
+		MatchParam("order_by", "updated_at").
+		MatchParam("sort", "desc").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merges.json")
+
+	client := NewDefault()
+	opts := scm.PullRequestListOptions{
+		State:     "open",
+		Base:      "master",
+		Head:      "feature",
+		Sort:      "updated",
+		Direction: "desc",
+	}
+	got, _, err := client.PullRequests.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/merges.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullListChanges(t *testing.T) {
 	gock.New("https://gitlab.com").
 		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/changes").
@@ -129,6 +297,80 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullRebase(t *testing.T) {
+	defer gock.Off()
+	defer func(interval time.Duration) { rebasePollInterval = interval }(rebasePollInterval)
+	rebasePollInterval = time.Millisecond
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/rebase").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_rebase.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		MatchParam("include_rebase_in_progress", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_rebase.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		MatchParam("include_rebase_in_progress", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_rebase_done.json")
+
+	client := NewDefault()
+	res, err := client.PullRequests.Rebase(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect the rebase status to be polled until done")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRebase_Timeout(t *testing.T) {
+	defer gock.Off()
+	defer func(interval, timeout time.Duration) {
+		rebasePollInterval = interval
+		rebaseTimeout = timeout
+	}(rebasePollInterval, rebaseTimeout)
+	rebasePollInterval = time.Millisecond
+	rebaseTimeout = 10 * time.Millisecond
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/rebase").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_rebase.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		MatchParam("include_rebase_in_progress", "true").
+		Persist().
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_rebase.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.Rebase(context.Background(), "diaspora/diaspora", 1347)
+	if err == nil {
+		t.Errorf("Expect a timeout error")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -150,6 +392,71 @@ func TestPullClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullFindCombinedStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/12d65c8dd2b2676fa3ac47d955accc085a37a9c1/statuses").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.FindCombinedStatus(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CombinedStatus)
+	raw, _ := ioutil.ReadFile("testdata/combined_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestPullListLinkedIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/closes_issues").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issues.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListLinkedIssues(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestPullCommentFind(t *testing.T) {
 	defer gock.Off()
 
@@ -268,3 +575,57 @@ func TestPullCommentDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/raw_diffs").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.diff")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.FindDiff(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/merge.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullApprovalCount(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/approvals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_approvals.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ApprovalCount(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Approvals{Approved: 1, Required: 2}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -5,19 +5,23 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/url"
+	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/batch"
 	"github.com/jenkins-x/go-scm/scm/driver/internal/null"
 )
 
 type repository struct {
-	ID            int         `json:"id"`
+	ID            int64       `json:"id"`
 	Path          string      `json:"path"`
 	PathNamespace string      `json:"path_with_namespace"`
 	DefaultBranch string      `json:"default_branch"`
@@ -27,11 +31,14 @@ type repository struct {
 	HTTPURL       string      `json:"http_url_to_repo"`
 	Namespace     namespace   `json:"namespace"`
 	Permissions   permissions `json:"permissions"`
+	EmptyRepo     bool        `json:"empty_repo"`
+	OpenIssues    int         `json:"open_issues_count"`
 }
 
 type namespace struct {
-	Name string `json:"name"`
-	Path string `json:"path"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	FullPath string `json:"full_path"`
 }
 
 type permissions struct {
@@ -45,9 +52,9 @@ type access struct {
 }
 
 type hook struct {
-	ID                    int       `json:"id"`
+	ID                    int64     `json:"id"`
 	URL                   string    `json:"url"`
-	ProjectID             int       `json:"project_id"`
+	ProjectID             int64     `json:"project_id"`
 	PushEvents            bool      `json:"push_events"`
 	IssuesEvents          bool      `json:"issues_events"`
 	MergeRequestsEvents   bool      `json:"merge_requests_events"`
@@ -60,12 +67,23 @@ type hook struct {
 	CreatedAt             time.Time `json:"created_at"`
 }
 
+// maxStatusLabelLength is the maximum length of a commit status
+// name accepted by GitLab.
+const maxStatusLabelLength = 255
+
 type repositoryService struct {
 	client *wrapper
 }
 
+// FindCombinedStatus returns the combined status of the commit.
+// GitLab has no combined status endpoint, so the state is
+// combined from the commit statuses.
 func (s *repositoryService) FindCombinedStatus(ctx context.Context, repo, ref string) (*scm.CombinedStatus, *scm.Response, error) {
-	panic("implement me")
+	statuses, res, err := s.ListStatus(ctx, repo, ref, scm.ListOptions{Size: 100})
+	if err != nil {
+		return nil, res, err
+	}
+	return convertCombinedStatus(ref, statuses), res, nil
 }
 
 func (s *repositoryService) FindUserPermission(ctx context.Context, repo string, user string) (string, *scm.Response, error) {
@@ -73,7 +91,7 @@ func (s *repositoryService) FindUserPermission(ctx context.Context, repo string,
 }
 
 func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user string) (bool, *scm.Response, error) {
-	users, resp, err := s.ListCollaborators(ctx, repo)
+	users, resp, err := s.ListCollaborators(ctx, repo, scm.ListOptions{})
 	if err != nil {
 		return false, resp, err
 	}
@@ -85,13 +103,212 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user strin
 	return false, resp, err
 }
 
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/members/all", encode(repo))
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/members/all?%s", encode(repo), encodeListOptions(opts))
 	out := []*user{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertUserList(out), res, err
 }
 
+func (s *repositoryService) ListCollaboratorsWithPermission(context.Context, string, scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListContributors lists the contributors to the repository.
+// GitLab identifies the contributors by the commit author name
+// and email, so the user login is not populated.
+//
+// See https://docs.gitlab.com/ee/api/repositories.html#contributors
+func (s *repositoryService) ListContributors(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/contributors?%s", encode(repo), encodeListOptions(opts))
+	out := []*contributor{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertContributorList(out), res, err
+}
+
+// AddCollaborator adds the user as a project member with the
+// access level matching the permission. GitLab adds members
+// directly, so no invitation is ever created.
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *scm.Response, error) {
+	id, res, err := s.findUserID(ctx, user)
+	if err != nil {
+		return false, res, err
+	}
+	params := url.Values{}
+	params.Set("user_id", strconv.FormatInt(id, 10))
+	params.Set("access_level", strconv.Itoa(convertFromPermission(permission)))
+	path := fmt.Sprintf("api/v4/projects/%s/members?%s", encode(repo), params.Encode())
+	res, err = s.client.do(ctx, "POST", path, nil, nil)
+	return false, res, err
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, user string) (*scm.Response, error) {
+	id, res, err := s.findUserID(ctx, user)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/members/%d", encode(repo), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) ListInvitations(context.Context, string, scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteInvitation(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// UploadAttachment uploads the file to the project uploads,
+// and returns the Markdown reference to embed in comments.
+//
+// See https://docs.gitlab.com/ee/api/projects.html#upload-a-file
+func (s *repositoryService) UploadAttachment(ctx context.Context, repo, name string, r io.Reader) (*scm.Attachment, *scm.Response, error) {
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	part, err := w.CreateFormFile("file", name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+	req := &scm.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("api/v4/projects/%s/uploads", encode(repo)),
+		Header: map[string][]string{
+			"Content-Type": {w.FormDataContentType()},
+		},
+		Body: buf,
+	}
+	out := new(upload)
+	res, err := s.client.send(ctx, req, out)
+	return convertUpload(out), res, err
+}
+
+func (s *repositoryService) Create(context.Context, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) CreateFromTemplate(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Star(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/star", encode(repo))
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *repositoryService) Unstar(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/unstar", encode(repo))
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// SetArchived archives or unarchives the project.
+func (s *repositoryService) SetArchived(ctx context.Context, repo string, archived bool) (*scm.Response, error) {
+	action := "unarchive"
+	if archived {
+		action = "archive"
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/%s", encode(repo), action)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// TriggerPipeline creates a new pipeline for the ref. The
+// variables are sorted by key so that the request is stable.
+func (s *repositoryService) TriggerPipeline(ctx context.Context, repo, ref string, vars map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipeline", encode(repo))
+	in := &pipelineInput{
+		Ref:       ref,
+		Variables: []*pipelineVariable{},
+	}
+	keys := []string{}
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		in.Variables = append(in.Variables, &pipelineVariable{
+			Key:   key,
+			Value: vars[key],
+		})
+	}
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPipeline(out), res, err
+}
+
+func (s *repositoryService) DispatchWorkflow(context.Context, string, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Dispatch(context.Context, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListSecrets(context.Context, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetSecret(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteSecret(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DownloadArchive(ctx context.Context, repo, ref, format string) (io.ReadCloser, *scm.Response, error) {
+	switch format {
+	case scm.ArchiveFormatTarGz, scm.ArchiveFormatZip:
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+	params := url.Values{}
+	params.Set("sha", ref)
+	path := fmt.Sprintf("api/v4/projects/%s/repository/archive.%s?%s", encode(repo), format, params.Encode())
+	return s.client.stream(ctx, path)
+}
+
+// findUserID returns the numeric id of the user with the
+// given username.
+func (s *repositoryService) findUserID(ctx context.Context, login string) (int64, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/users?username=%s", url.QueryEscape(login))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return 0, res, err
+	}
+	if len(out) == 0 {
+		return 0, res, scm.ErrNotFound
+	}
+	return out[0].ID, res, nil
+}
+
+// helper function resolves the logins to user ids, returning a
+// scm.MissingUsers error listing the logins that do not exist.
+func (s *repositoryService) findUserIDs(ctx context.Context, logins []string, action string) ([]int64, error) {
+	ids := []int64{}
+	missing := scm.MissingUsers{Action: action}
+	for _, login := range logins {
+		id, _, err := s.findUserID(ctx, login)
+		if err == scm.ErrNotFound {
+			missing.Users = append(missing.Users, login)
+			continue
+		} else if err != nil {
+			return ids, err
+		}
+		ids = append(ids, id)
+	}
+	if len(missing.Users) > 0 {
+		return ids, missing
+	}
+	return ids, nil
+}
+
 func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
 	panic("implement me")
 }
@@ -103,6 +320,23 @@ func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Reposit
 	return convertRepository(out), res, err
 }
 
+func (s *repositoryService) FindWithViewerState(context.Context, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Exists returns true if the repository exists and is visible
+// to the authenticated user.
+func (s *repositoryService) Exists(ctx context.Context, repo string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	res, err := s.client.do(ctx, "HEAD", path, nil, nil)
+	if err == scm.ErrNotFound {
+		return false, res, nil
+	} else if err != nil {
+		return false, res, err
+	}
+	return true, res, nil
+}
+
 func (s *repositoryService) FindHook(ctx context.Context, repo string, id string) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s", encode(repo), id)
 	out := new(hook)
@@ -124,6 +358,13 @@ func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*
 	return convertRepositoryList(out), res, err
 }
 
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	if opts.Affiliation != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	return s.List(ctx, scm.ListOptions{Page: opts.Page, Size: opts.Size})
+}
+
 func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/hooks?%s", encode(repo), encodeListOptions(opts))
 	out := []*hook{}
@@ -166,6 +407,12 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	if input.Events.Tag {
 		params.Set("tag_push_events", "true")
 	}
+	if input.Events.Pipeline {
+		params.Set("pipeline_events", "true")
+	}
+	if input.Events.Job {
+		params.Set("job_events", "true")
+	}
 
 	path := fmt.Sprintf("api/v4/projects/%s/hooks?%s", encode(repo), params.Encode())
 	out := new(hook)
@@ -174,6 +421,9 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 }
 
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+	if err := scm.CheckStatusLabel(input.Label, maxStatusLabelLength); err != nil {
+		return nil, nil, err
+	}
 	params := url.Values{}
 	params.Set("state", convertFromState(input.State))
 	params.Set("name", input.Label)
@@ -184,6 +434,17 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 	return convertStatus(out), res, err
 }
 
+func (s *repositoryService) CreateStatuses(ctx context.Context, repo, ref string, inputs []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	statuses, err := batch.CreateStatuses(ctx, inputs, func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		return s.CreateStatus(ctx, repo, ref, input)
+	})
+	return statuses, nil, err
+}
+
+func (s *repositoryService) DeleteStatus(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s", encode(repo), id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
@@ -203,8 +464,8 @@ func convertRepositoryList(from []*repository) []*scm.Repository {
 // to the common repository structure.
 func convertRepository(from *repository) *scm.Repository {
 	to := &scm.Repository{
-		ID:        strconv.Itoa(from.ID),
-		Namespace: from.Namespace.Path,
+		ID:        strconv.FormatInt(from.ID, 10),
+		Namespace: from.Namespace.FullPath,
 		Name:      from.Path,
 		Branch:    from.DefaultBranch,
 		Private:   convertPrivate(from.Visibility),
@@ -215,11 +476,10 @@ func convertRepository(from *repository) *scm.Repository {
 			Push:  canPush(from),
 			Admin: canAdmin(from),
 		},
+		OpenIssues: from.OpenIssues,
 	}
 	if to.Namespace == "" {
-		if parts := strings.SplitN(from.PathNamespace, "/", 2); len(parts) == 2 {
-			to.Namespace = parts[1]
-		}
+		to.Namespace, _ = scm.SplitFull(from.PathNamespace)
 	}
 	return to
 }
@@ -233,12 +493,14 @@ func convertHookList(from []*hook) []*scm.Hook {
 }
 
 func convertHook(from *hook) *scm.Hook {
+	events := convertEvents(from)
 	return &scm.Hook{
-		ID:         strconv.Itoa(from.ID),
+		ID:         strconv.FormatInt(from.ID, 10),
 		Active:     true,
 		Target:     from.URL,
-		Events:     convertEvents(from),
+		Events:     events,
 		SkipVerify: !from.EnableSslVerification,
+		Parsed:     parseHookEvents(events),
 	}
 }
 
@@ -253,20 +515,97 @@ type status struct {
 	Updated time.Time   `json:"updated_at"`
 }
 
+type pipeline struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Ref    string `json:"ref"`
+	Sha    string `json:"sha"`
+	WebURL string `json:"web_url"`
+}
+
+type pipelineInput struct {
+	Ref       string              `json:"ref"`
+	Variables []*pipelineVariable `json:"variables"`
+}
+
+type pipelineVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type contributor struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Commits int    `json:"commits"`
+}
+
+func convertContributorList(from []*contributor) []*scm.Contributor {
+	to := []*scm.Contributor{}
+	for _, v := range from {
+		to = append(to, &scm.Contributor{
+			User: scm.User{
+				Name:  v.Name,
+				Email: v.Email,
+			},
+			Commits: v.Commits,
+		})
+	}
+	return to
+}
+
+type upload struct {
+	Alt      string `json:"alt"`
+	URL      string `json:"url"`
+	Markdown string `json:"markdown"`
+}
+
+func convertUpload(from *upload) *scm.Attachment {
+	return &scm.Attachment{
+		Name:     from.Alt,
+		URL:      from.URL,
+		Markdown: from.Markdown,
+	}
+}
+
+func convertPipeline(from *pipeline) *scm.Pipeline {
+	return &scm.Pipeline{
+		ID:     from.ID,
+		Status: convertState(from.Status),
+		Ref:    from.Ref,
+		Sha:    from.Sha,
+		Link:   from.WebURL,
+	}
+}
+
+func convertCombinedStatus(ref string, from []*scm.Status) *scm.CombinedStatus {
+	states := []scm.State{}
+	for _, v := range from {
+		states = append(states, v.State)
+	}
+	return &scm.CombinedStatus{
+		State:    scm.CombineStates(states...),
+		Sha:      ref,
+		Statuses: from,
+	}
+}
+
 func convertStatusList(from []*status) []*scm.Status {
 	to := []*scm.Status{}
 	for _, v := range from {
 		to = append(to, convertStatus(v))
 	}
+	scm.SortStatuses(to)
 	return to
 }
 
 func convertStatus(from *status) *scm.Status {
 	return &scm.Status{
-		State:  convertState(from.Status),
-		Label:  from.Name,
-		Desc:   from.Desc.String,
-		Target: from.Target.String,
+		State:   convertState(from.Status),
+		Label:   from.Name,
+		Desc:    from.Desc.String,
+		Target:  from.Target.String,
+		Created: from.Created,
+		Updated: from.Updated,
 	}
 }
 
@@ -287,6 +626,38 @@ func convertEvents(from *hook) []string {
 	if from.MergeRequestsEvents {
 		events = append(events, "merge")
 	}
+	if from.PipelineEvents {
+		events = append(events, "pipeline")
+	}
+	if from.JobEvents {
+		events = append(events, "job")
+	}
+	return events
+}
+
+// helper function parses the native hook events into the
+// structured hook events.
+func parseHookEvents(from []string) scm.HookEvents {
+	var events scm.HookEvents
+	for _, event := range from {
+		switch event {
+		case "issues":
+			events.Issue = true
+		case "tag":
+			events.Tag = true
+		case "push":
+			events.Push = true
+		case "comment":
+			events.IssueComment = true
+			events.PullRequestComment = true
+		case "merge":
+			events.PullRequest = true
+		case "pipeline":
+			events.Pipeline = true
+		case "job":
+			events.Job = true
+		}
+	}
 	return events
 }
 
@@ -352,3 +723,16 @@ func canAdmin(proj *repository) bool {
 		return false
 	}
 }
+
+// convertFromPermission maps a repository permission to the
+// closest GitLab member access level.
+func convertFromPermission(from string) int {
+	switch from {
+	case "admin", "maintain":
+		return 40
+	case "push", "write":
+		return 30
+	default:
+		return 20
+	}
+}
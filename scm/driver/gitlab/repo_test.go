@@ -5,9 +5,12 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -49,6 +52,92 @@ func TestRepositoryFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryFind_Subgroup(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/gitlab-org/frontend/web-ide").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo_subgroup.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Find(context.Background(), "gitlab-org/frontend/web-ide")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Namespace, "gitlab-org/frontend"; got != want {
+		t.Errorf("Want repository namespace %q, got %q", want, got)
+	}
+	if got, want := got.Name, "web-ide"; got != want {
+		t.Errorf("Want repository name %q, got %q", want, got)
+	}
+}
+
+func TestRepositoryFind_LargeID(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id":4294967297,"path":"diaspora","path_with_namespace":"diaspora/diaspora","namespace":{"path":"diaspora","full_path":"diaspora"}}`)
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Find(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.ID, "4294967297"; got != want {
+		t.Errorf("Want repository id %q, got %q", want, got)
+	}
+}
+
+func TestRepositoryExists(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Head("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Exists(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Expect repository exists")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryExists_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Head("/api/v4/projects/diaspora/diaspora").
+		Reply(404).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Exists(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got {
+		t.Errorf("Expect repository does not exist")
+	}
+}
+
 func TestRepositoryPerms(t *testing.T) {
 	defer gock.Off()
 
@@ -96,8 +185,8 @@ func TestRepositoryNotFound(t *testing.T) {
 		t.Errorf("Expect Not Found error")
 		return
 	}
-	if got, want := err.Error(), "404 Project Not Found"; got != want {
-		t.Errorf("Want error %q, got %q", want, got)
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %q, got %q", scm.ErrNotFound, err)
 	}
 }
 
@@ -148,7 +237,7 @@ func TestListContributor(t *testing.T) {
 		File("testdata/contributors.json")
 
 	client := NewDefault()
-	got, res, err := client.Repositories.ListCollaborators(context.Background(), "diaspora/diaspora")
+	got, res, err := client.Repositories.ListCollaborators(context.Background(), "diaspora/diaspora", scm.ListOptions{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -168,6 +257,40 @@ func TestListContributor(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestRepositoryListContributors(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/contributors").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/repository_contributors.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListContributors(context.Background(), "diaspora/diaspora", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Contributor{}
+	raw, _ := ioutil.ReadFile("testdata/repository_contributors.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
 func TestStatusList(t *testing.T) {
 	defer gock.Off()
 
@@ -242,6 +365,46 @@ func TestStatusCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestStatusCreate_LabelTooLong(t *testing.T) {
+	defer gock.Off()
+
+	label := strings.Repeat("a", 255)
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		MatchParam("name", label).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/status.json")
+
+	client := NewDefault()
+	_, _, err := client.Repositories.CreateStatus(context.Background(), "diaspora/diaspora", "6dcb09b5b57875f334f61aebed695e2e4193db5e", &scm.StatusInput{
+		Label: label + "a",
+		State: scm.StateSuccess,
+	})
+	if !errors.As(err, new(scm.StatusLabelTooLong)) {
+		t.Errorf("Expect StatusLabelTooLong error, got %v", err)
+	}
+	if !gock.IsPending() {
+		t.Errorf("Expect the label over the maximum length not to be sent")
+	}
+
+	_, _, err = client.Repositories.CreateStatus(context.Background(), "diaspora/diaspora", "6dcb09b5b57875f334f61aebed695e2e4193db5e", &scm.StatusInput{
+		Label: label,
+		State: scm.StateSuccess,
+	})
+	if err != nil {
+		t.Errorf("Expect label of the maximum length to be accepted, got %v", err)
+	}
+}
+
+func TestStatusDelete(t *testing.T) {
+	_, err := NewDefault().Repositories.DeleteStatus(context.Background(), "diaspora/diaspora", "master", "continuous-integration/drone")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestRepositoryHookFind(t *testing.T) {
 	defer gock.Off()
 
@@ -330,6 +493,113 @@ func TestRepositoryHookDelete(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryStar(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/star").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	res, err := client.Repositories.Star(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 201; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositorySetArchived(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/archive").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	res, err := client.Repositories.SetArchived(context.Background(), "diaspora/diaspora", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositorySetArchived_Unarchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/unarchive").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	_, err := client.Repositories.SetArchived(context.Background(), "diaspora/diaspora", false)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryTriggerPipeline(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipeline").
+		MatchType("json").
+		BodyString(`{"ref":"master","variables":\[{"key":"DEPLOY","value":"true"},{"key":"TARGET","value":"staging"}\]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	client := NewDefault()
+	vars := map[string]string{
+		"TARGET": "staging",
+		"DEPLOY": "true",
+	}
+	got, res, err := client.Repositories.TriggerPipeline(context.Background(), "diaspora/diaspora", "master", vars)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryDispatchWorkflow(t *testing.T) {
+	client := NewDefault()
+	_, err := client.Repositories.DispatchWorkflow(context.Background(), "diaspora/diaspora", "deploy.yml", "master", nil)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestRepositoryHookCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -614,3 +884,172 @@ func TestCanAdmin(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/users_username.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/members").
+		MatchParam("user_id", "1").
+		MatchParam("access_level", "30").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	invited, res, err := client.Repositories.AddCollaborator(context.Background(), "diaspora/diaspora", "john_smith", "push")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if invited {
+		t.Errorf("Expect members to be added without an invitation")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/users_username.json")
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/members/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.RemoveCollaborator(context.Background(), "diaspora/diaspora", "john_smith")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestConvertFromPermission(t *testing.T) {
+	tests := []struct {
+		src string
+		dst int
+	}{
+		{"admin", 40},
+		{"maintain", 40},
+		{"push", 30},
+		{"write", 30},
+		{"pull", 20},
+		{"read", 20},
+	}
+	for _, test := range tests {
+		if got, want := convertFromPermission(test.src), test.dst; got != want {
+			t.Errorf("Want permission %q converted to %d, got %d", test.src, want, got)
+		}
+	}
+}
+
+func TestRepositoryDownloadArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/archive.tar.gz").
+		MatchParam("sha", "master").
+		Reply(200).
+		Type("application/x-gzip").
+		SetHeaders(mockHeaders).
+		File("testdata/archive.tar.gz")
+
+	client := NewDefault()
+	body, res, err := client.Repositories.DownloadArchive(context.Background(), "diaspora/diaspora", "master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer body.Close()
+
+	got, _ := ioutil.ReadAll(body)
+	want, _ := ioutil.ReadFile("testdata/archive.tar.gz")
+	if !bytes.Equal(got, want) {
+		t.Errorf("Unexpected archive contents")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUploadAttachment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/uploads").
+		MatchHeader("Content-Type", "^multipart/form-data; boundary=").
+		BodyString(`name="file"; filename="screenshot.png"`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/upload.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.UploadAttachment(context.Background(), "diaspora/diaspora", "screenshot.png", strings.NewReader("fake png"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Attachment)
+	raw, _ := ioutil.ReadFile("testdata/upload.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestParseHookEvents(t *testing.T) {
+	tests := []scm.HookEvents{
+		{Issue: true},
+		{Tag: true},
+		{Push: true},
+		{IssueComment: true, PullRequestComment: true},
+		{PullRequest: true},
+		{Pipeline: true},
+		{Job: true},
+	}
+	for i, in := range tests {
+		h := &hook{
+			IssuesEvents:        in.Issue,
+			TagPushEvents:       in.Tag,
+			PushEvents:          in.Push,
+			NoteEvents:          in.IssueComment,
+			MergeRequestsEvents: in.PullRequest,
+			PipelineEvents:      in.Pipeline,
+			JobEvents:           in.Job,
+		}
+		got := parseHookEvents(convertEvents(h))
+		if diff := cmp.Diff(got, in); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
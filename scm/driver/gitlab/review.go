@@ -6,6 +6,9 @@ package gitlab
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -18,14 +21,257 @@ func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (
 	return nil, nil, scm.ErrNotSupported
 }
 
+// List returns the diff notes of the merge request discussions.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+	out := []*discussion{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDiscussionList(out), res, err
+}
+
+// ListComments returns the positioned notes of the merge request
+// discussions. General discussion notes are skipped.
+func (s *reviewService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
+	return s.List(ctx, repo, number, opts)
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// CreateComment starts a merge request discussion positioned on the
+// given file line. The position requires the merge request diff
+// refs, which are looked up before creating the discussion.
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.Review, *scm.Response, error) {
+	mr := new(pr)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number), nil, mr)
+	if err != nil {
+		return nil, res, err
+	}
+	sha := mr.DiffRefs.HeadSha
+	if input.Sha != "" {
+		sha = input.Sha
+	}
+	params := url.Values{}
+	params.Set("body", input.Body)
+	params.Set("position[position_type]", "text")
+	params.Set("position[base_sha]", mr.DiffRefs.BaseSha)
+	params.Set("position[start_sha]", mr.DiffRefs.StartSha)
+	params.Set("position[head_sha]", sha)
+	params.Set("position[old_path]", input.Path)
+	params.Set("position[new_path]", input.Path)
+	if input.Side == scm.ReviewSideLeft {
+		params.Set("position[old_line]", strconv.Itoa(input.Line))
+	} else {
+		params.Set("position[new_line]", strconv.Itoa(input.Line))
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, params.Encode())
+	out := new(discussion)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Notes) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	review := convertReviewNote(out.Notes[0])
+	review.ThreadID = out.ID
+	return review, res, nil
+}
+
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) EditComment(ctx context.Context, repo string, number, id int, body string) (*scm.Review, *scm.Response, error) {
+	in := url.Values{}
+	in.Set("body", body)
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d?%s", encode(repo), number, id, in.Encode())
+	out := new(reviewNote)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertReviewNote(out), res, err
+}
+
+func (s *reviewService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d", encode(repo), number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ListThreads returns the merge request discussions that are
+// attached to the diff. General discussions are skipped.
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int) ([]*scm.ReviewThread, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	to := []*scm.ReviewThread{}
+	for {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+		out := []*discussion{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		to = append(to, convertDiscussionThreadList(out)...)
+		if res.Page.Next == 0 {
+			return to, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return to, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// ResolveThread resolves the merge request discussion with the
+// given discussion id.
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return s.resolveThread(ctx, repo, number, threadID, true)
+}
+
+// UnresolveThread unresolves the merge request discussion with
+// the given discussion id.
+func (s *reviewService) UnresolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return s.resolveThread(ctx, repo, number, threadID, false)
+}
+
+func (s *reviewService) resolveThread(ctx context.Context, repo string, number int, threadID string, resolved bool) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s?resolved=%t", encode(repo), number, threadID, resolved)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// CreateCommentReply adds a note to the merge request discussion
+// that contains the note with the inReplyTo id.
+func (s *reviewService) CreateCommentReply(ctx context.Context, repo string, number, inReplyTo int, body string) (*scm.Comment, *scm.Response, error) {
+	discussion, res, err := s.findDiscussion(ctx, repo, number, inReplyTo)
+	if err != nil {
+		return nil, res, err
+	}
+	in := url.Values{}
+	in.Set("body", body)
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s/notes?%s", encode(repo), number, discussion.ID, in.Encode())
+	out := new(issueComment)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	reply := convertIssueComment(out)
+	reply.InReplyTo = inReplyTo
+	return reply, res, nil
+}
+
+// findDiscussion returns the merge request discussion that
+// contains the note with the given id.
+func (s *reviewService) findDiscussion(ctx context.Context, repo string, number, noteID int) (*discussion, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+		out := []*discussion{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, d := range out {
+			for _, note := range d.Notes {
+				if note.ID == noteID {
+					return d, res, nil
+				}
+			}
+		}
+		if res.Page.Next == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+type discussion struct {
+	ID    string        `json:"id"`
+	Notes []*reviewNote `json:"notes"`
+}
+
+type reviewNote struct {
+	issueComment
+	Position *notePosition `json:"position"`
+	Resolved bool          `json:"resolved"`
+}
+
+type notePosition struct {
+	PositionType string `json:"position_type"`
+	BaseSha      string `json:"base_sha"`
+	StartSha     string `json:"start_sha"`
+	HeadSha      string `json:"head_sha"`
+	OldPath      string `json:"old_path"`
+	NewPath      string `json:"new_path"`
+	OldLine      int    `json:"old_line"`
+	NewLine      int    `json:"new_line"`
+}
+
+// helper function to convert the merge request discussions
+// to a list of review comments, skipping notes that are not
+// attached to the diff.
+func convertDiscussionList(from []*discussion) []*scm.Review {
+	to := []*scm.Review{}
+	for _, d := range from {
+		for _, note := range d.Notes {
+			if note.Position == nil {
+				continue
+			}
+			review := convertReviewNote(note)
+			review.ThreadID = d.ID
+			to = append(to, review)
+		}
+	}
+	return to
+}
+
+// helper function to convert the merge request discussions
+// to a list of review threads, skipping discussions that are
+// not attached to the diff.
+func convertDiscussionThreadList(from []*discussion) []*scm.ReviewThread {
+	to := []*scm.ReviewThread{}
+	for _, d := range from {
+		thread := &scm.ReviewThread{ID: d.ID}
+		for _, note := range d.Notes {
+			if note.Position == nil {
+				continue
+			}
+			review := convertReviewNote(note)
+			review.ThreadID = d.ID
+			thread.Comments = append(thread.Comments, review)
+		}
+		if len(thread.Comments) == 0 {
+			continue
+		}
+		thread.Path = thread.Comments[0].Path
+		thread.Line = thread.Comments[0].Line
+		thread.Resolved = thread.Comments[0].Resolved
+		to = append(to, thread)
+	}
+	return to
+}
+
+func convertReviewNote(from *reviewNote) *scm.Review {
+	to := &scm.Review{
+		ID:   from.ID,
+		Body: from.Body,
+		Author: scm.User{
+			Name:   from.User.Name,
+			Login:  from.User.Username,
+			Avatar: from.User.AvatarURL,
+		},
+		Created:  from.CreatedAt,
+		Updated:  from.UpdatedAt,
+		Resolved: from.Resolved,
+	}
+	if pos := from.Position; pos != nil {
+		to.Sha = pos.HeadSha
+		to.Path = pos.NewPath
+		to.Line = pos.NewLine
+		if pos.NewLine == 0 {
+			to.Path = pos.OldPath
+			to.Line = pos.OldLine
+		}
+	}
+	return to
+}
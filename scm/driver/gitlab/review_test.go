@@ -6,8 +6,12 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 	"github.com/jenkins-x/go-scm/scm"
 )
 
@@ -20,10 +24,69 @@ func TestReviewFind(t *testing.T) {
 }
 
 func TestReviewList(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/merge_review_discussions.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/merge_review_discussions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReviewListComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/merge_review_discussions.json")
+
+	client := NewDefault()
+	opts := scm.ListOptions{Page: 1, Size: 30}
+	want, _, err := client.Reviews.List(context.Background(), "diaspora/diaspora", 1, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	got, _, err := client.Reviews.ListComments(context.Background(), "diaspora/diaspora", 1, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Expect the same review comments as List")
+		t.Log(diff)
 	}
 }
 
@@ -35,6 +98,56 @@ func TestReviewCreate(t *testing.T) {
 	}
 }
 
+func TestReviewCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("body", "Please rename this variable").
+		MatchParam("position[position_type]", "text").
+		MatchParam("position[base_sha]", "c380d3acebd181f13629a25d2e2acca46ffe1e00").
+		MatchParam("position[start_sha]", "c380d3acebd181f13629a25d2e2acca46ffe1e00").
+		MatchParam("position[head_sha]", "12d65c8dd2b2676fa3ac47d955accc085a37a9c1").
+		MatchParam("position[new_path]", "README.md").
+		MatchParam("position[new_line]", "7").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion.json")
+
+	input := &scm.ReviewCommentInput{
+		Body: "Please rename this variable",
+		Path: "README.md",
+		Line: 7,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.CreateComment(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/merge_discussion.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestReviewDelete(t *testing.T) {
 	service := new(reviewService)
 	_, err := service.Delete(context.Background(), "diaspora/diaspora", 1, 1)
@@ -42,3 +155,185 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewCreateCommentReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussions.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7/notes").
+		MatchParam("body", "Comment for MR").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_note.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.CreateCommentReply(context.Background(), "diaspora/diaspora", 1, 300, "Comment for MR")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/merge_note_reply.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewCreateCommentReply_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussions.json")
+
+	client := NewDefault()
+	_, _, err := client.Reviews.CreateCommentReply(context.Background(), "diaspora/diaspora", 1, 999, "Comment for MR")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestReviewEditComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1/notes/302").
+		MatchParam("body", "Please rename this variable").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_diff_note.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.EditComment(context.Background(), "diaspora/diaspora", 1, 302, "Please rename this variable")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/merge_diff_note.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewDeleteComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/merge_requests/1/notes/302").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.DeleteComment(context.Background(), "diaspora/diaspora", 1, 302)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6").
+		MatchParam("resolved", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.ResolveThread(context.Background(), "diaspora/diaspora", 1, "87805b7c09016a7058e91bdbe7b29d1f284a39e6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewUnresolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6").
+		MatchParam("resolved", "false").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.UnresolveThread(context.Background(), "diaspora/diaspora", 1, "87805b7c09016a7058e91bdbe7b29d1f284a39e6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_review_discussions.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListThreads(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/merge_review_threads.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
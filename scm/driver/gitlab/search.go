@@ -0,0 +1,90 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+// Repositories searches the projects visible to the authenticated
+// user. The org: and user: qualifiers of the query limit the search
+// to the projects of a group, including its subgroups, or of a user.
+// The topic: qualifiers are translated to the topic filter, and the
+// remaining terms are matched against the project name and path.
+// Other qualifiers are not supported and return an error. The total
+// count is read from the X-Total header, which GitLab omits for very
+// large result sets.
+func (s *searchService) Repositories(ctx context.Context, query string, opts scm.ListOptions) (*scm.RepositorySearchResult, *scm.Response, error) {
+	path, err := encodeProjectSearch(query, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	result := &scm.RepositorySearchResult{
+		Repositories: convertRepositoryList(out),
+	}
+	result.Total, _ = strconv.Atoi(res.Header.Get("X-Total"))
+	return result, res, nil
+}
+
+// helper function to encode the project search query and the
+// list options as the path of the project listing.
+func encodeProjectSearch(query string, opts scm.ListOptions) (string, error) {
+	path := "api/v4/projects"
+	params := url.Values{}
+	var owner string
+	var topics, terms []string
+	for _, field := range strings.Fields(query) {
+		key, value, ok := strings.Cut(field, ":")
+		if !ok {
+			terms = append(terms, field)
+			continue
+		}
+		switch key {
+		case "topic":
+			topics = append(topics, value)
+		case "org", "user":
+			if owner != "" {
+				return "", fmt.Errorf("search query %q has more than one org or user qualifier", query)
+			}
+			owner = value
+			if key == "org" {
+				path = fmt.Sprintf("api/v4/groups/%s/projects", encode(value))
+				params.Set("include_subgroups", "true")
+			} else {
+				path = fmt.Sprintf("api/v4/users/%s/projects", encode(value))
+			}
+		default:
+			return "", fmt.Errorf("unsupported search qualifier %q", key)
+		}
+	}
+	if len(topics) != 0 {
+		params.Set("topic", strings.Join(topics, ","))
+	}
+	if len(terms) != 0 {
+		params.Set("search", strings.Join(terms, " "))
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
+	}
+	return path + "?" + params.Encode(), nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects").
+		MatchParam("topic", "go").
+		MatchParam("search", "diaspora").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		SetHeader("X-Total", "1").
+		File("testdata/repos.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Repositories(context.Background(), "topic:go diaspora", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.RepositorySearchResult)
+	raw, _ := ioutil.ReadFile("testdata/search_repositories.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchRepositories_Org(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/diaspora/projects").
+		MatchParam("include_subgroups", "true").
+		MatchParam("topic", "go").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		SetHeader("X-Total", "1").
+		File("testdata/repos.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Repositories(context.Background(), "org:diaspora topic:go", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.RepositorySearchResult)
+	raw, _ := ioutil.ReadFile("testdata/search_repositories.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func Test_encodeProjectSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: "topic:go topic:cli migration tool",
+			want:  "api/v4/projects?page=2&per_page=100&search=migration+tool&topic=go%2Ccli",
+		},
+		{
+			query: "org:gitlab-org/security topic:go",
+			want:  "api/v4/groups/gitlab-org%2Fsecurity/projects?include_subgroups=true&page=2&per_page=100&topic=go",
+		},
+		{
+			query: "user:octocat tool",
+			want:  "api/v4/users/octocat/projects?page=2&per_page=100&search=tool",
+		},
+	}
+	for _, test := range tests {
+		got, err := encodeProjectSearch(test.query, scm.ListOptions{Page: 2, Size: 1000})
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Want encoded project search %q, got %q", test.want, got)
+		}
+	}
+}
+
+func Test_encodeProjectSearch_Unsupported(t *testing.T) {
+	for _, query := range []string{"language:go tool", "org:foo user:bar"} {
+		if _, err := encodeProjectSearch(query, scm.ListOptions{}); err == nil {
+			t.Errorf("Expect error for search query %q", query)
+		}
+	}
+}
@@ -41,7 +41,27 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+func (s *userService) AcceptInvitation(context.Context, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// ListStarred returns the projects starred by the authenticated
+// user. The starred projects endpoint is keyed by user, so the
+// authenticated user is looked up first.
+func (s *userService) ListStarred(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	me := new(user)
+	res, err := s.client.do(ctx, "GET", "api/v4/user", nil, me)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/users/%d/starred_projects?%s", me.ID, encodeListOptions(opts))
+	out := []*repository{}
+	res, err = s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 type user struct {
+	ID       int64       `json:"id"`
 	Username string      `json:"username"`
 	Name     string      `json:"name"`
 	Email    null.String `json:"email"`
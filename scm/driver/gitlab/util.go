@@ -8,10 +8,21 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
 
+// maxPageSize is the largest per_page value accepted by GitLab.
+const maxPageSize = 100
+
+func pageSize(size int) int {
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
+
 func encode(s string) string {
 	return strings.Replace(s, "/", "%2F", -1)
 }
@@ -22,7 +33,7 @@ func encodeListOptions(opts scm.ListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	return params.Encode()
 }
@@ -34,7 +45,7 @@ func encodeMemberListOptions(opts scm.ListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	return params.Encode()
 }
@@ -45,7 +56,7 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	if opts.Ref != "" {
 		params.Set("ref_name", opts.Ref)
@@ -59,7 +70,7 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
@@ -68,6 +79,27 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Open {
 		params.Set("state", "opened")
 	}
+	if !opts.Since.IsZero() {
+		params.Set("updated_after", opts.Since.Format(time.RFC3339))
+	}
+	switch opts.Sort {
+	case "created":
+		params.Set("order_by", "created_at")
+	case "updated":
+		params.Set("order_by", "updated_at")
+	}
+	if opts.Direction != "" {
+		params.Set("sort", opts.Direction)
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee_username", opts.Assignee)
+	}
+	if opts.Creator != "" {
+		params.Set("author_username", opts.Creator)
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
 	return params.Encode()
 }
 
@@ -77,14 +109,48 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 		params.Set("page", strconv.Itoa(opts.Page))
 	}
 	if opts.Size != 0 {
-		params.Set("per_page", strconv.Itoa(opts.Size))
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
 	}
-	if opts.Open && opts.Closed {
+	switch {
+	case opts.State == "open":
+		params.Set("state", "opened")
+	case opts.State != "":
+		params.Set("state", opts.State)
+	case opts.Open && opts.Closed:
 		params.Set("state", "all")
-	} else if opts.Closed {
+	case opts.Closed:
 		params.Set("state", "closed")
-	} else if opts.Open {
+	case opts.Open:
 		params.Set("state", "opened")
 	}
+	if opts.Base != "" {
+		params.Set("target_branch", opts.Base)
+	}
+	if opts.Head != "" {
+		params.Set("source_branch", opts.Head)
+	}
+	if opts.Sort != "" {
+		params.Set("order_by", opts.Sort+"_at")
+	}
+	if opts.Direction != "" {
+		params.Set("sort", opts.Direction)
+	}
+	return params.Encode()
+}
+
+func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(pageSize(opts.Size)))
+	}
+	switch opts.State {
+	case "", "open":
+		params.Set("state", "active")
+	case "closed":
+		params.Set("state", "closed")
+	}
 	return params.Encode()
 }
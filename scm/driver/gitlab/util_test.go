@@ -6,6 +6,7 @@ package gitlab
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -22,6 +23,18 @@ func Test_encodeListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeListOptions_MaxPageSize(t *testing.T) {
+	opts := scm.ListOptions{
+		Page: 1,
+		Size: 1000,
+	}
+	want := "page=1&per_page=100"
+	got := encodeListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeMemberListOptions(t *testing.T) {
 	opts := scm.ListOptions{
 		Page: 10,
@@ -61,6 +74,48 @@ func Test_encodeIssueListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Since(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Page:  10,
+		Size:  30,
+		Since: time.Date(2019, time.May, 1, 10, 30, 0, 0, time.UTC),
+	}
+	want := "page=10&per_page=30&updated_after=2019-05-01T10%3A30%3A00Z"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Sort(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Page:      10,
+		Size:      30,
+		Sort:      "updated",
+		Direction: "desc",
+	}
+	want := "order_by=updated_at&page=10&per_page=30&sort=desc"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Page:     10,
+		Size:     30,
+		Assignee: "octocat",
+		Creator:  "hubot",
+		Labels:   []string{"bug", "ui"},
+	}
+	want := "assignee_username=octocat&author_username=hubot&labels=bug%2Cui&page=10&per_page=30"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions_Opened(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
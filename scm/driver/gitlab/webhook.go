@@ -36,6 +36,12 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		return nil, scm.UnknownWebhook{event}
 	case "Merge Request Hook":
 		hook, err = parsePullRequestHook(data)
+	case "Pipeline Hook":
+		hook, err = parsePipelineHook(data)
+	case "Job Hook":
+		hook, err = parseJobHook(data)
+	case "Note Hook":
+		hook, err = parseCommentHook(data)
 	default:
 		return nil, scm.UnknownWebhook{event}
 	}
@@ -108,12 +114,46 @@ func parsePullRequestHook(data []byte) (scm.Webhook, error) {
 	}
 }
 
+func parsePipelineHook(data []byte) (scm.Webhook, error) {
+	src := new(pipelineHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertPipelineHook(src), nil
+}
+
+func parseJobHook(data []byte) (scm.Webhook, error) {
+	src := new(jobHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertJobHook(src), nil
+}
+
+func parseCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(commentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	switch src.ObjectAttributes.NoteableType {
+	case "Issue":
+		return convertIssueCommentHook(src), nil
+	case "MergeRequest":
+		return convertPullRequestCommentHook(src), nil
+	default:
+		return nil, scm.UnknownWebhook{src.ObjectAttributes.NoteableType}
+	}
+}
+
 func convertPushHook(src *pushHook) *scm.PushHook {
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
 	dst := &scm.PushHook{
 		Ref: scm.ExpandRef(src.Ref, "refs/heads/"),
 		Repo: scm.Repository{
-			ID:        strconv.Itoa(src.Project.ID),
+			ID:        strconv.FormatInt(src.Project.ID, 10),
 			Namespace: namespace,
 			Name:      name,
 			Clone:     src.Project.GitHTTPURL,
@@ -160,7 +200,7 @@ func converBranchHook(src *pushHook) *scm.BranchHook {
 		action = scm.ActionDelete
 		commit = src.Before
 	}
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
 	return &scm.BranchHook{
 		Action: action,
 		Ref: scm.Reference{
@@ -168,7 +208,7 @@ func converBranchHook(src *pushHook) *scm.BranchHook {
 			Sha:  commit,
 		},
 		Repo: scm.Repository{
-			ID:        strconv.Itoa(src.Project.ID),
+			ID:        strconv.FormatInt(src.Project.ID, 10),
 			Namespace: namespace,
 			Name:      name,
 			Clone:     src.Project.GitHTTPURL,
@@ -193,7 +233,7 @@ func convertTagHook(src *pushHook) *scm.TagHook {
 		action = scm.ActionDelete
 		commit = src.Before
 	}
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
 	return &scm.TagHook{
 		Action: action,
 		Ref: scm.Reference{
@@ -201,7 +241,7 @@ func convertTagHook(src *pushHook) *scm.TagHook {
 			Sha:  commit,
 		},
 		Repo: scm.Repository{
-			ID:        strconv.Itoa(src.Project.ID),
+			ID:        strconv.FormatInt(src.Project.ID, 10),
 			Namespace: namespace,
 			Name:      name,
 			Clone:     src.Project.GitHTTPURL,
@@ -237,9 +277,9 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 		src.ObjectAttributes.Source.Namespace,
 		src.ObjectAttributes.Source.Name,
 	)
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
 	repo := scm.Repository{
-		ID:        strconv.Itoa(src.Project.ID),
+		ID:        strconv.FormatInt(src.Project.ID, 10),
 		Namespace: namespace,
 		Name:      name,
 		Clone:     src.Project.GitHTTPURL,
@@ -292,6 +332,172 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+func convertIssueCommentHook(src *commentHook) *scm.IssueCommentHook {
+	return &scm.IssueCommentHook{
+		Action: convertCommentAction(src.ObjectAttributes.Action),
+		Repo:   convertCommentHookRepository(src),
+		Issue: scm.Issue{
+			Number: src.Issue.Iid,
+			Title:  src.Issue.Title,
+			Body:   src.Issue.Description,
+			Link:   src.Issue.URL,
+			Closed: src.Issue.State == "closed",
+		},
+		Comment: convertCommentHookComment(src),
+		Sender:  convertCommentHookUser(src),
+	}
+}
+
+func convertPullRequestCommentHook(src *commentHook) *scm.PullRequestCommentHook {
+	repo := convertCommentHookRepository(src)
+	ref := fmt.Sprintf("refs/merge-requests/%d/head", src.MergeRequest.Iid)
+	sha := src.MergeRequest.LastCommit.ID
+	pr := scm.PullRequest{
+		Number: src.MergeRequest.Iid,
+		Title:  src.MergeRequest.Title,
+		Body:   src.MergeRequest.Description,
+		Sha:    sha,
+		Ref:    ref,
+		Base: scm.PullRequestBranch{
+			Ref:  ref,
+			Repo: repo,
+		},
+		Head: scm.PullRequestBranch{
+			Sha:  sha,
+			Repo: repo,
+		},
+		Source: src.MergeRequest.SourceBranch,
+		Target: src.MergeRequest.TargetBranch,
+		Fork:   src.MergeRequest.Source.PathWithNamespace,
+		Link:   src.MergeRequest.URL,
+		Closed: src.MergeRequest.State != "opened",
+		Merged: src.MergeRequest.State == "merged",
+	}
+	return &scm.PullRequestCommentHook{
+		Action:      convertCommentAction(src.ObjectAttributes.Action),
+		Repo:        repo,
+		PullRequest: pr,
+		Comment:     convertCommentHookComment(src),
+		Sender:      convertCommentHookUser(src),
+	}
+}
+
+// helper function returns the comment hook action. GitLab
+// does not send note events for deleted comments, and older
+// versions do not set the action, in which case the comment
+// is assumed to be created.
+func convertCommentAction(src string) scm.Action {
+	switch src {
+	case "update":
+		return scm.ActionUpdate
+	default:
+		return scm.ActionCreate
+	}
+}
+
+func convertCommentHookRepository(src *commentHook) scm.Repository {
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
+	return scm.Repository{
+		ID:        strconv.FormatInt(src.Project.ID, 10),
+		Namespace: namespace,
+		Name:      name,
+		Clone:     src.Project.GitHTTPURL,
+		CloneSSH:  src.Project.GitSSHURL,
+		Link:      src.Project.WebURL,
+		Branch:    src.Project.DefaultBranch,
+		Private:   false, // TODO how do we correctly set Private vs Public?
+	}
+}
+
+func convertCommentHookComment(src *commentHook) scm.Comment {
+	return scm.Comment{
+		ID:     src.ObjectAttributes.ID,
+		Body:   src.ObjectAttributes.Note,
+		Link:   src.ObjectAttributes.URL,
+		Author: convertCommentHookUser(src),
+	}
+}
+
+func convertCommentHookUser(src *commentHook) scm.User {
+	return scm.User{
+		Login:  src.User.Username,
+		Name:   src.User.Name,
+		Avatar: src.User.AvatarURL,
+	}
+}
+
+// helper function returns the reference for the pipeline or
+// job ref, which GitLab sends without the refs/ prefix.
+func convertJobRef(ref, sha string, tag bool) scm.Reference {
+	path := scm.ExpandRef(ref, "refs/heads/")
+	if tag {
+		path = scm.ExpandRef(ref, "refs/tags/")
+	}
+	return scm.Reference{
+		Name: ref,
+		Path: path,
+		Sha:  sha,
+	}
+}
+
+func convertPipelineHook(src *pipelineHook) *scm.PipelineHook {
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
+	return &scm.PipelineHook{
+		ID:     src.ObjectAttributes.ID,
+		Status: convertState(src.ObjectAttributes.Status),
+		Ref:    convertJobRef(src.ObjectAttributes.Ref, src.ObjectAttributes.Sha, src.ObjectAttributes.Tag),
+		Stages: src.ObjectAttributes.Stages,
+		Link:   fmt.Sprintf("%s/pipelines/%d", src.Project.WebURL, src.ObjectAttributes.ID),
+		Repo: scm.Repository{
+			ID:        strconv.FormatInt(src.Project.ID, 10),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Email:  src.User.Email,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
+func convertJobHook(src *jobHook) *scm.JobHook {
+	namespace, name := scm.SplitFull(src.Project.PathWithNamespace)
+	if src.Project.PathWithNamespace == "" {
+		namespace, name = scm.SplitFull(src.ProjectName)
+	}
+	return &scm.JobHook{
+		ID:         src.BuildID,
+		Name:       src.BuildName,
+		Stage:      src.BuildStage,
+		Status:     convertState(src.BuildStatus),
+		Ref:        convertJobRef(src.Ref, src.Sha, src.Tag),
+		PipelineID: src.PipelineID,
+		Repo: scm.Repository{
+			ID:        strconv.FormatInt(src.ProjectID, 10),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Repository.GitHTTPURL,
+			CloneSSH:  src.Repository.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false, // TODO how do we correctly set Private vs Public?
+		},
+		Sender: scm.User{
+			Login:  src.User.Username,
+			Name:   src.User.Name,
+			Email:  src.User.Email,
+			Avatar: src.User.AvatarURL,
+		},
+	}
+}
+
 type (
 	pushHook struct {
 		ObjectKind   string      `json:"object_kind"`
@@ -306,9 +512,9 @@ type (
 		UserUsername string      `json:"user_username"`
 		UserEmail    string      `json:"user_email"`
 		UserAvatar   string      `json:"user_avatar"`
-		ProjectID    int         `json:"project_id"`
+		ProjectID    int64       `json:"project_id"`
 		Project      struct {
-			ID                int         `json:"id"`
+			ID                int64       `json:"id"`
 			Name              string      `json:"name"`
 			Description       string      `json:"description"`
 			WebURL            string      `json:"web_url"`
@@ -357,9 +563,9 @@ type (
 			Username  string `json:"username"`
 			AvatarURL string `json:"avatar_url"`
 		} `json:"user"`
-		ProjectID int `json:"project_id"`
+		ProjectID int64 `json:"project_id"`
 		Project   struct {
-			ID                int         `json:"id"`
+			ID                int64       `json:"id"`
 			Name              string      `json:"name"`
 			Description       string      `json:"description"`
 			WebURL            string      `json:"web_url"`
@@ -378,12 +584,13 @@ type (
 		} `json:"project"`
 		ObjectAttributes struct {
 			ID           int         `json:"id"`
+			Action       string      `json:"action"`
 			Note         string      `json:"note"`
 			NoteableType string      `json:"noteable_type"`
 			AuthorID     int         `json:"author_id"`
 			CreatedAt    string      `json:"created_at"`
 			UpdatedAt    string      `json:"updated_at"`
-			ProjectID    int         `json:"project_id"`
+			ProjectID    int64       `json:"project_id"`
 			Attachment   interface{} `json:"attachment"`
 			LineCode     string      `json:"line_code"`
 			CommitID     string      `json:"commit_id"`
@@ -434,6 +641,14 @@ type (
 			Description string `json:"description"`
 			Homepage    string `json:"homepage"`
 		} `json:"repository"`
+		Issue struct {
+			ID          int    `json:"id"`
+			Iid         int    `json:"iid"`
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			State       string `json:"state"`
+			URL         string `json:"url"`
+		} `json:"issue"`
 		MergeRequest struct {
 			AssigneeID     interface{} `json:"assignee_id"`
 			AuthorID       int         `json:"author_id"`
@@ -455,10 +670,10 @@ type (
 			MergeWhenPipelineSucceeds bool        `json:"merge_when_pipeline_succeeds"`
 			MilestoneID               interface{} `json:"milestone_id"`
 			SourceBranch              string      `json:"source_branch"`
-			SourceProjectID           int         `json:"source_project_id"`
+			SourceProjectID           int64       `json:"source_project_id"`
 			State                     string      `json:"state"`
 			TargetBranch              string      `json:"target_branch"`
-			TargetProjectID           int         `json:"target_project_id"`
+			TargetProjectID           int64       `json:"target_project_id"`
 			TimeEstimate              int         `json:"time_estimate"`
 			Title                     string      `json:"title"`
 			UpdatedAt                 string      `json:"updated_at"`
@@ -530,9 +745,9 @@ type (
 		UserUsername string      `json:"user_username"`
 		UserEmail    string      `json:"user_email"`
 		UserAvatar   string      `json:"user_avatar"`
-		ProjectID    int         `json:"project_id"`
+		ProjectID    int64       `json:"project_id"`
 		Project      struct {
-			ID                int         `json:"id"`
+			ID                int64       `json:"id"`
 			Name              string      `json:"name"`
 			Description       string      `json:"description"`
 			WebURL            string      `json:"web_url"`
@@ -582,7 +797,7 @@ type (
 			AvatarURL string `json:"avatar_url"`
 		} `json:"user"`
 		Project struct {
-			ID                int         `json:"id"`
+			ID                int64       `json:"id"`
 			Name              string      `json:"name"`
 			Description       string      `json:"description"`
 			WebURL            string      `json:"web_url"`
@@ -615,7 +830,7 @@ type (
 			LastEditedByID      int           `json:"last_edited_by_id"`
 			MilestoneID         interface{}   `json:"milestone_id"`
 			MovedToID           interface{}   `json:"moved_to_id"`
-			ProjectID           int           `json:"project_id"`
+			ProjectID           int64         `json:"project_id"`
 			RelativePosition    int           `json:"relative_position"`
 			State               string        `json:"state"`
 			TimeEstimate        int           `json:"time_estimate"`
@@ -633,7 +848,7 @@ type (
 			ID          int         `json:"id"`
 			Title       string      `json:"title"`
 			Color       string      `json:"color"`
-			ProjectID   int         `json:"project_id"`
+			ProjectID   int64       `json:"project_id"`
 			CreatedAt   string      `json:"created_at"`
 			UpdatedAt   string      `json:"updated_at"`
 			Template    bool        `json:"template"`
@@ -648,7 +863,7 @@ type (
 					ID          int         `json:"id"`
 					Title       string      `json:"title"`
 					Color       string      `json:"color"`
-					ProjectID   int         `json:"project_id"`
+					ProjectID   int64       `json:"project_id"`
 					CreatedAt   string      `json:"created_at"`
 					UpdatedAt   string      `json:"updated_at"`
 					Template    bool        `json:"template"`
@@ -674,7 +889,7 @@ type (
 			AvatarURL string `json:"avatar_url"`
 		} `json:"user"`
 		Project struct {
-			ID                int         `json:"id"`
+			ID                int64       `json:"id"`
 			Name              string      `json:"name"`
 			Description       string      `json:"description"`
 			WebURL            string      `json:"web_url"`
@@ -712,10 +927,10 @@ type (
 			MergeWhenPipelineSucceeds bool        `json:"merge_when_pipeline_succeeds"`
 			MilestoneID               interface{} `json:"milestone_id"`
 			SourceBranch              string      `json:"source_branch"`
-			SourceProjectID           int         `json:"source_project_id"`
+			SourceProjectID           int64       `json:"source_project_id"`
 			State                     string      `json:"state"`
 			TargetBranch              string      `json:"target_branch"`
-			TargetProjectID           int         `json:"target_project_id"`
+			TargetProjectID           int64       `json:"target_project_id"`
 			TimeEstimate              int         `json:"time_estimate"`
 			Title                     string      `json:"title"`
 			UpdatedAt                 string      `json:"updated_at"`
@@ -783,4 +998,64 @@ type (
 			Homepage    string `json:"homepage"`
 		} `json:"repository"`
 	}
+
+	pipelineHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			ID         int      `json:"id"`
+			Ref        string   `json:"ref"`
+			Tag        bool     `json:"tag"`
+			Sha        string   `json:"sha"`
+			BeforeSha  string   `json:"before_sha"`
+			Source     string   `json:"source"`
+			Status     string   `json:"status"`
+			Stages     []string `json:"stages"`
+			CreatedAt  string   `json:"created_at"`
+			FinishedAt string   `json:"finished_at"`
+			Duration   int      `json:"duration"`
+		} `json:"object_attributes"`
+		User    jobUser    `json:"user"`
+		Project jobProject `json:"project"`
+	}
+
+	jobHook struct {
+		ObjectKind  string     `json:"object_kind"`
+		Ref         string     `json:"ref"`
+		Tag         bool       `json:"tag"`
+		BeforeSha   string     `json:"before_sha"`
+		Sha         string     `json:"sha"`
+		BuildID     int        `json:"build_id"`
+		BuildName   string     `json:"build_name"`
+		BuildStage  string     `json:"build_stage"`
+		BuildStatus string     `json:"build_status"`
+		PipelineID  int        `json:"pipeline_id"`
+		ProjectID   int64      `json:"project_id"`
+		ProjectName string     `json:"project_name"`
+		User        jobUser    `json:"user"`
+		Project     jobProject `json:"project"`
+		Repository  struct {
+			Name       string `json:"name"`
+			Homepage   string `json:"homepage"`
+			GitHTTPURL string `json:"git_http_url"`
+			GitSSHURL  string `json:"git_ssh_url"`
+		} `json:"repository"`
+	}
+
+	jobUser struct {
+		ID        int64  `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	}
+
+	jobProject struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		WebURL            string `json:"web_url"`
+		GitSSHURL         string `json:"git_ssh_url"`
+		GitHTTPURL        string `json:"git_http_url"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+	}
 )
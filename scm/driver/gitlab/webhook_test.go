@@ -65,13 +65,19 @@ func TestWebhooks(t *testing.T) {
 		// 	after:  "testdata/webhooks/issues_opened.json.golden",
 		// 	obj:    new(scm.IssueHook),
 		// },
-		// // issue comment hooks
-		// {
-		// 	event:  "issue_comment",
-		// 	before: "testdata/webhooks/issue_comment_created.json",
-		// 	after:  "testdata/webhooks/issue_comment_created.json.golden",
-		// 	obj:    new(scm.IssueCommentHook),
-		// },
+		// issue comment hooks
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/issue_comment_create.json",
+			after:  "testdata/webhooks/issue_comment_create.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/issue_comment_update.json",
+			after:  "testdata/webhooks/issue_comment_update.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
 		// pull request hooks
 		{
 			event:  "Merge Request Hook",
@@ -109,13 +115,33 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_merge.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
-		// // pull request comment hooks
-		// {
-		// 	event:  "issue_comment",
-		// 	before: "testdata/webhooks/pull_request_comment_created.json",
-		// 	after:  "testdata/webhooks/pull_request_comment_created.json.golden",
-		// 	obj:    new(scm.PullRequestCommentHook),
-		// },
+		// pipeline hooks
+		{
+			event:  "Pipeline Hook",
+			before: "testdata/webhooks/pipeline_success.json",
+			after:  "testdata/webhooks/pipeline_success.json.golden",
+			obj:    new(scm.PipelineHook),
+		},
+		// job hooks
+		{
+			event:  "Job Hook",
+			before: "testdata/webhooks/job_failed.json",
+			after:  "testdata/webhooks/job_failed.json.golden",
+			obj:    new(scm.JobHook),
+		},
+		// pull request comment hooks
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/pull_request_comment_create.json",
+			after:  "testdata/webhooks/pull_request_comment_create.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		{
+			event:  "Note Hook",
+			before: "testdata/webhooks/pull_request_comment_update.json",
+			after:  "testdata/webhooks/pull_request_comment_update.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
 	}
 
 	for _, test := range tests {
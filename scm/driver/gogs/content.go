@@ -40,3 +40,11 @@ func (s *contentService) Update(ctx context.Context, repo, path string, params *
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *contentService) Tree(ctx context.Context, repo, ref string, recursive bool) ([]*scm.FileEntry, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *contentService) BatchUpdate(ctx context.Context, repo string, input *scm.BatchCommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -24,6 +24,18 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	panic("implement me")
 }
 
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CherryPick(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Revert(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -38,6 +50,10 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -86,6 +102,7 @@ type (
 	commitDetail struct {
 		Sha       string    `json:"sha"`
 		Commit    commit    `json:"commit"`
+		Author    committer `json:"author"`
 		Committer committer `json:"committer"`
 	}
 
@@ -99,9 +116,10 @@ type (
 
 	// gogs signature object.
 	signature struct {
-		Name     string `json:"name"`
-		Email    string `json:"email"`
-		Username string `json:"username"`
+		Name     string    `json:"name"`
+		Email    string    `json:"email"`
+		Username string    `json:"username"`
+		Date     time.Time `json:"date"`
 	}
 )
 
@@ -138,24 +156,20 @@ func convertCommit(src *commitDetail) *scm.Commit {
 		Sha:       src.Sha,
 		Link:      src.Commit.URL,
 		Message:   src.Commit.Message,
-		Author:    convertSignature(src.Commit.Author),
-		Committer: convertCommitter(src.Committer),
-	}
-}
-
-func convertSignature(src signature) scm.Signature {
-	return scm.Signature{
-		Login: src.Username,
-		Email: src.Email,
-		Name:  src.Name,
+		Author:    convertCommitSignature(src.Commit.Author, src.Author),
+		Committer: convertCommitSignature(src.Commit.Committer, src.Committer),
 	}
 }
 
-func convertCommitter(src committer) scm.Signature {
+// helper function to convert the git signature of a commit,
+// populating the login and avatar from the matching gogs
+// account when the email is linked to a user.
+func convertCommitSignature(src signature, account committer) scm.Signature {
 	return scm.Signature{
-		Avatar: src.AvatarURL,
-		Login:  src.Login,
+		Name:   src.Name,
 		Email:  src.Email,
-		Name:   src.FullName,
+		Date:   src.Date,
+		Login:  account.Login,
+		Avatar: account.AvatarURL,
 	}
 }
@@ -20,6 +20,10 @@ func (s *issueService) AssignIssue(ctx context.Context, repo string, number int,
 	panic("implement me")
 }
 
+func (s *issueService) SetAssignees(context.Context, string, int, []string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *issueService) UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -65,6 +69,18 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 	return convertIssueCommentList(out), res, err
 }
 
+// ListAllComments returns the issue comments, which include the
+// pull request conversation comments. Inline review comments are
+// not supported.
+func (s *issueService) ListAllComments(ctx context.Context, repo string, number int) ([]*scm.Comment, *scm.Response, error) {
+	comments, res, err := s.ListComments(ctx, repo, number, scm.ListOptions{})
+	if err != nil {
+		return nil, res, err
+	}
+	scm.SortComments(comments)
+	return comments, res, nil
+}
+
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	in := &issueInput{
@@ -95,14 +111,26 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) LockWithOptions(ctx context.Context, repo string, number int, opts scm.LockOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Transfer(ctx context.Context, repo string, number int, targetRepo string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
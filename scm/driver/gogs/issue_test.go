@@ -107,6 +107,14 @@ func TestIssueClose(t *testing.T) {
 	}
 }
 
+func TestIssueReopen(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.Reopen(context.Background(), "gogits/go-gogs-client", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestIssueLock(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, err := client.Issues.Lock(context.Background(), "gogits/go-gogs-client", 1)
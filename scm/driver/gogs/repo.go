@@ -7,6 +7,7 @@ package gogs
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"time"
 
@@ -29,10 +30,86 @@ func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user strin
 	panic("implement me")
 }
 
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *repositoryService) ListCollaboratorsWithPermission(context.Context, string, scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListContributors(context.Context, string, scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListInvitations(context.Context, string, scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteInvitation(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UploadAttachment(context.Context, string, string, io.Reader) (*scm.Attachment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Create(context.Context, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) CreateFromTemplate(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Star(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Unstar(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetArchived(context.Context, string, bool) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) TriggerPipeline(context.Context, string, string, map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DispatchWorkflow(context.Context, string, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Dispatch(context.Context, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListSecrets(context.Context, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetSecret(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteSecret(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DownloadArchive(context.Context, string, string, string) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
 	panic("implement me")
 }
@@ -44,6 +121,23 @@ func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Reposit
 	return convertRepository(out), res, err
 }
 
+func (s *repositoryService) FindWithViewerState(context.Context, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Exists returns true if the repository exists and is visible
+// to the authenticated user.
+func (s *repositoryService) Exists(ctx context.Context, repo string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	res, err := s.client.do(ctx, "HEAD", path, nil, nil)
+	if err == scm.ErrNotFound {
+		return false, res, nil
+	} else if err != nil {
+		return false, res, err
+	}
+	return true, res, nil
+}
+
 func (s *repositoryService) FindHook(ctx context.Context, repo string, id string) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, id)
 	out := new(hook)
@@ -51,6 +145,9 @@ func (s *repositoryService) FindHook(ctx context.Context, repo string, id string
 	return convertHook(out), res, err
 }
 
+// FindPerms returns the repository permissions of the
+// authenticated user, as reported by the permissions object
+// of the repository resource.
 func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s", repo)
 	out := new(repository)
@@ -65,6 +162,13 @@ func (s *repositoryService) List(ctx context.Context, _ scm.ListOptions) ([]*scm
 	return convertRepositoryList(out), res, err
 }
 
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	if opts.Affiliation != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	return s.List(ctx, scm.ListOptions{Page: opts.Page, Size: opts.Size})
+}
+
 func (s *repositoryService) ListHooks(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks", repo)
 	out := []*hook{}
@@ -97,6 +201,14 @@ func (s *repositoryService) CreateStatus(context.Context, string, string, *scm.S
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) CreateStatuses(context.Context, string, string, []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteStatus(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
@@ -109,7 +221,7 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 type (
 	// gogs repository resource.
 	repository struct {
-		ID            int       `json:"id"`
+		ID            int64     `json:"id"`
 		Owner         user      `json:"owner"`
 		Name          string    `json:"name"`
 		FullName      string    `json:"full_name"`
@@ -133,7 +245,7 @@ type (
 
 	// gogs hook resource.
 	hook struct {
-		ID     int        `json:"id"`
+		ID     int64      `json:"id"`
 		Type   string     `json:"type"`
 		Events []string   `json:"events"`
 		Active bool       `json:"active"`
@@ -162,7 +274,7 @@ func convertRepositoryList(src []*repository) []*scm.Repository {
 
 func convertRepository(src *repository) *scm.Repository {
 	return &scm.Repository{
-		ID:        strconv.Itoa(src.ID),
+		ID:        strconv.FormatInt(src.ID, 10),
 		Namespace: userLogin(&src.Owner),
 		Name:      src.Name,
 		Perm:      convertPerm(src.Permissions),
@@ -191,10 +303,11 @@ func convertHookList(src []*hook) []*scm.Hook {
 
 func convertHook(from *hook) *scm.Hook {
 	return &scm.Hook{
-		ID:     strconv.Itoa(from.ID),
+		ID:     strconv.FormatInt(from.ID, 10),
 		Active: from.Active,
 		Target: from.Config.URL,
 		Events: from.Events,
+		Parsed: parseHookEvents(from.Events),
 	}
 }
 
@@ -218,3 +331,25 @@ func convertHookEvent(from scm.HookEvents) []string {
 	}
 	return events
 }
+
+// helper function parses the native hook events into the
+// structured hook events.
+func parseHookEvents(from []string) scm.HookEvents {
+	var events scm.HookEvents
+	for _, event := range from {
+		switch event {
+		case "pull_request":
+			events.PullRequest = true
+		case "issues":
+			events.Issue = true
+		case "issue_comment":
+			events.IssueComment = true
+		case "create", "delete":
+			events.Branch = true
+			events.Tag = true
+		case "push":
+			events.Push = true
+		}
+	}
+	return events
+}
@@ -69,6 +69,33 @@ func TestRepoFindPerm(t *testing.T) {
 	}
 }
 
+func TestRepoFindPerm_Push(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_push.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.FindPerms(context.Background(), "gogits/gogs")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Perm{
+		Pull:  true,
+		Push:  true,
+		Admin: false,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestRepoList(t *testing.T) {
 	defer gock.Off()
 
@@ -106,8 +133,8 @@ func TestRepoNotFound(t *testing.T) {
 	_, _, err := client.Repositories.FindPerms(context.Background(), "gogits/go-gogs-client")
 	if err == nil {
 		t.Errorf("Expect Not Found error")
-	} else if got, want := err.Error(), "Not Found"; got != want {
-		t.Errorf("Want error %q, got %q", want, got)
+	} else if err != scm.ErrNotFound {
+		t.Errorf("Want error %q, got %q", scm.ErrNotFound, err)
 	}
 }
 
@@ -276,3 +303,20 @@ func TestStatusCreate(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestParseHookEvents(t *testing.T) {
+	tests := []scm.HookEvents{
+		{Push: true},
+		{Branch: true, Tag: true},
+		{IssueComment: true},
+		{Issue: true},
+		{PullRequest: true},
+	}
+	for i, in := range tests {
+		got := parseHookEvents(convertHookEvent(in))
+		if diff := cmp.Diff(got, in); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
@@ -33,12 +33,20 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+func (s *userService) AcceptInvitation(context.Context, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListStarred(context.Context, scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
 
 type user struct {
-	ID       int    `json:"id"`
+	ID       int64  `json:"id"`
 	Login    string `json:"login"`
 	Username string `json:"username"`
 	Fullname string `json:"full_name"`
@@ -0,0 +1,39 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package baseurl normalizes the base URLs used to create
+// driver clients.
+package baseurl
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Parse parses the raw base url and normalizes the path
+// so that it ends with exactly one trailing slash. An error
+// is returned if the url cannot be parsed, the scheme is
+// not http or https, or the host is missing.
+func Parse(uri string) (*url.URL, error) {
+	base, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	switch base.Scheme {
+	case "http", "https":
+	case "":
+		return nil, fmt.Errorf("missing scheme in base url %q", uri)
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q in base url %q", base.Scheme, uri)
+	}
+	if base.Host == "" {
+		return nil, fmt.Errorf("missing host in base url %q", uri)
+	}
+	base.Path = strings.TrimRight(base.Path, "/") + "/"
+	if base.RawPath != "" {
+		base.RawPath = strings.TrimRight(base.RawPath, "/") + "/"
+	}
+	return base, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package baseurl
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{"https://host", "https://host/"},
+		{"https://host/", "https://host/"},
+		{"https://host//", "https://host/"},
+		{"http://host:8080/api/v3", "http://host:8080/api/v3/"},
+		{"https://host/api/v3//", "https://host/api/v3/"},
+	}
+	for _, test := range tests {
+		got, err := Parse(test.uri)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %s", test.uri, err)
+			continue
+		}
+		if got.String() != test.want {
+			t.Errorf("Want %q parsed as %q, got %q", test.uri, test.want, got.String())
+		}
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	tests := []string{
+		"host",
+		"host/api/v3",
+		"ftp://host",
+		"https://",
+		"http://a b.com/",
+	}
+	for _, uri := range tests {
+		if _, err := Parse(uri); err == nil {
+			t.Errorf("Expect error parsing %q", uri)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package batch issues repeated API calls for providers
+// that lack bulk endpoints.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Concurrency is the maximum number of requests in flight.
+const Concurrency = 4
+
+// CreateStatusFunc creates a single commit status.
+type CreateStatusFunc func(context.Context, *scm.StatusInput) (*scm.Status, *scm.Response, error)
+
+// CreateStatuses creates the commit statuses concurrently using at
+// most Concurrency requests at a time. The created statuses are
+// returned in input order, with a nil entry for each failed input.
+// Failures are joined into the returned error. If the context is
+// cancelled the remaining inputs are not started, and the context
+// error is joined into the returned error.
+func CreateStatuses(ctx context.Context, inputs []*scm.StatusInput, create CreateStatusFunc) ([]*scm.Status, error) {
+	statuses := make([]*scm.Status, len(inputs))
+	errs := make([]error, len(inputs))
+	sem := make(chan struct{}, Concurrency)
+	var cancelled error
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		if cancelled = acquire(ctx, sem); cancelled != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, input *scm.StatusInput) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			status, _, err := create(ctx, input)
+			if err != nil {
+				errs[i] = fmt.Errorf("status %q: %w", input.Label, err)
+				return
+			}
+			statuses[i] = status
+		}(i, input)
+	}
+	wg.Wait()
+	return statuses, errors.Join(append(errs, cancelled)...)
+}
+
+// acquire takes a slot of the semaphore, or returns the context
+// error if the context is cancelled first.
+func acquire(ctx context.Context, sem chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ListHooksFunc lists the hooks of a single repository.
+type ListHooksFunc func(ctx context.Context, repo string) ([]*scm.Hook, error)
+
+// ListHooks lists the hooks of the repositories concurrently using
+// at most Concurrency requests at a time. The hooks are returned
+// keyed by repository, and failed repositories are omitted.
+// Failures are joined into the returned error. If the context is
+// cancelled the remaining repositories are not listed, and the
+// context error is joined into the returned error.
+func ListHooks(ctx context.Context, repos []string, list ListHooksFunc) (map[string][]*scm.Hook, error) {
+	hooks := map[string][]*scm.Hook{}
+	errs := make([]error, len(repos))
+	sem := make(chan struct{}, Concurrency)
+	var cancelled error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, repo := range repos {
+		if cancelled = acquire(ctx, sem); cancelled != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, repo string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			out, err := list(ctx, repo)
+			if err != nil {
+				errs[i] = fmt.Errorf("repository %q: %w", repo, err)
+				return
+			}
+			mu.Lock()
+			hooks[repo] = out
+			mu.Unlock()
+		}(i, repo)
+	}
+	wg.Wait()
+	return hooks, errors.Join(append(errs, cancelled)...)
+}
@@ -0,0 +1,202 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestCreateStatuses(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		active  int32
+		maximum int32
+		posted  []string
+	)
+	create := func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		mu.Lock()
+		if n > maximum {
+			maximum = n
+		}
+		posted = append(posted, input.Label)
+		mu.Unlock()
+		return &scm.Status{Label: input.Label}, nil, nil
+	}
+
+	inputs := []*scm.StatusInput{}
+	for _, label := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		inputs = append(inputs, &scm.StatusInput{Label: label})
+	}
+	got, err := CreateStatuses(context.Background(), inputs, create)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(posted) != len(inputs) {
+		t.Errorf("Want %d statuses posted, got %d", len(inputs), len(posted))
+	}
+	for i, status := range got {
+		if status.Label != inputs[i].Label {
+			t.Errorf("Want status %d label %q, got %q", i, inputs[i].Label, status.Label)
+		}
+	}
+	if maximum > Concurrency {
+		t.Errorf("Want at most %d concurrent requests, got %d", Concurrency, maximum)
+	}
+}
+
+func TestCreateStatuses_Errors(t *testing.T) {
+	errBoom := errors.New("boom")
+	create := func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		if input.Label == "b" || input.Label == "d" {
+			return nil, nil, errBoom
+		}
+		return &scm.Status{Label: input.Label}, nil, nil
+	}
+
+	inputs := []*scm.StatusInput{
+		{Label: "a"},
+		{Label: "b"},
+		{Label: "c"},
+		{Label: "d"},
+	}
+	got, err := CreateStatuses(context.Background(), inputs, create)
+	if !errors.Is(err, errBoom) {
+		t.Errorf("Want joined error to wrap the failure, got %v", err)
+	}
+	if want := "status \"b\": boom\nstatus \"d\": boom"; err.Error() != want {
+		t.Errorf("Want error %q, got %q", want, err.Error())
+	}
+	if got[0] == nil || got[2] == nil {
+		t.Errorf("Want successful statuses to be returned")
+	}
+	if got[1] != nil || got[3] != nil {
+		t.Errorf("Want nil entries for failed statuses")
+	}
+}
+
+func TestCreateStatuses_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int32
+	// the calls hold their slot until the context is cancelled,
+	// which happens once the slots are full.
+	create := func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		if atomic.AddInt32(&calls, 1) == Concurrency {
+			cancel()
+		}
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	}
+
+	inputs := []*scm.StatusInput{}
+	for _, label := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		inputs = append(inputs, &scm.StatusInput{Label: label})
+	}
+	_, err := CreateStatuses(ctx, inputs, create)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Want context canceled error, got %v", err)
+	}
+	if calls > Concurrency+1 {
+		t.Errorf("Want the remaining statuses not to be created, got %d calls", calls)
+	}
+}
+
+func TestListHooks(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var (
+		entered int32
+		active  int32
+		maximum int32
+	)
+	// the first Concurrency calls wait until all of them have
+	// started, so that the slots are full at the same time. The
+	// timeout only guards against a deadlock if fewer calls are
+	// allowed to start.
+	full := make(chan struct{})
+	list := func(ctx context.Context, repo string) ([]*scm.Hook, error) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			m := atomic.LoadInt32(&maximum)
+			if n <= m || atomic.CompareAndSwapInt32(&maximum, m, n) {
+				break
+			}
+		}
+		if e := atomic.AddInt32(&entered, 1); e == Concurrency {
+			close(full)
+		} else if e < Concurrency {
+			select {
+			case <-full:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		if repo == "octocat/broken" {
+			return nil, scm.ErrNotFound
+		}
+		return []*scm.Hook{{ID: repo}}, nil
+	}
+
+	repos := []string{"octocat/broken"}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		repos = append(repos, "octocat/"+name)
+	}
+	got, err := ListHooks(ctx, repos, list)
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Want joined error to wrap the failure, got %v", err)
+	}
+	if len(got) != len(repos)-1 {
+		t.Errorf("Want hooks for %d repositories, got %d", len(repos)-1, len(got))
+	}
+	if _, ok := got["octocat/broken"]; ok {
+		t.Errorf("Want failed repositories to be omitted")
+	}
+	for _, repo := range repos[1:] {
+		if hooks := got[repo]; len(hooks) != 1 || hooks[0].ID != repo {
+			t.Errorf("Want hooks of repository %q, got %v", repo, hooks)
+		}
+	}
+	if maximum != Concurrency {
+		t.Errorf("Want %d concurrent requests, got %d", Concurrency, maximum)
+	}
+}
+
+func TestListHooks_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int32
+	// the calls hold their slot until the context is cancelled,
+	// which happens once the slots are full.
+	list := func(ctx context.Context, repo string) ([]*scm.Hook, error) {
+		if atomic.AddInt32(&calls, 1) == Concurrency {
+			cancel()
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	repos := []string{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		repos = append(repos, "octocat/"+name)
+	}
+	_, err := ListHooks(ctx, repos, list)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Want context canceled error, got %v", err)
+	}
+	if calls > Concurrency+1 {
+		t.Errorf("Want the remaining repositories not to be listed, got %d calls", calls)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package diff reads raw diffs returned by the provider APIs.
+package diff
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// MaxSize is the maximum number of bytes read from a raw diff.
+const MaxSize = 10 << 20
+
+// Read reads the raw diff from the reader and closes it. If
+// the diff is larger than MaxSize, scm.ErrDiffTooLarge is
+// returned without reading the remainder.
+func Read(r io.ReadCloser) ([]byte, error) {
+	defer r.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(r, MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > MaxSize {
+		return nil, scm.ErrDiffTooLarge
+	}
+	return b, nil
+}
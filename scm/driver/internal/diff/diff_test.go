@@ -0,0 +1,33 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diff
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestRead(t *testing.T) {
+	want := "diff --git a/README b/README\n"
+	got, err := Read(ioutil.NopCloser(strings.NewReader(want)))
+	if err != nil {
+		t.Error(err)
+	}
+	if string(got) != want {
+		t.Errorf("Want diff %q, got %q", want, got)
+	}
+}
+
+func TestRead_TooLarge(t *testing.T) {
+	r := bytes.NewReader(make([]byte, MaxSize+1))
+	_, err := Read(ioutil.NopCloser(r))
+	if err != scm.ErrDiffTooLarge {
+		t.Errorf("Want diff too large error, got %v", err)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/url"
+	"path"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -38,3 +40,100 @@ func (s *contentService) Update(ctx context.Context, repo, path string, params *
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+// Tree returns the repository tree. The recursive tree is
+// listed with the files endpoint, which only includes files,
+// and the top level tree is listed with the browse endpoint.
+func (s *contentService) Tree(ctx context.Context, repo, ref string, recursive bool) ([]*scm.FileEntry, *scm.Response, error) {
+	if !recursive {
+		return s.browse(ctx, repo, ref)
+	}
+	namespace, name := scm.Split(repo)
+	entries := []*scm.FileEntry{}
+	start := 0
+	for {
+		endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/files?at=%s&limit=1000&start=%d", namespace, name, url.QueryEscape(ref), start)
+		out := new(files)
+		res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out.Values {
+			entries = append(entries, &scm.FileEntry{
+				Name: path.Base(v),
+				Path: v,
+				Type: "file",
+			})
+		}
+		if out.pagination.LastPage.Bool || !out.pagination.NextPage.Valid {
+			return entries, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return entries, res, err
+		}
+		start = int(out.pagination.NextPage.Int64)
+	}
+}
+
+func (s *contentService) BatchUpdate(ctx context.Context, repo string, input *scm.BatchCommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *contentService) browse(ctx context.Context, repo, ref string) ([]*scm.FileEntry, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse?at=%s&limit=1000", namespace, name, url.QueryEscape(ref))
+	out := new(browse)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if res != nil {
+		res.Truncated = !out.Children.pagination.LastPage.Bool
+	}
+	return convertBrowseEntryList(out.Children.Values), res, err
+}
+
+type files struct {
+	pagination
+	Values []string `json:"values"`
+}
+
+type browse struct {
+	Children struct {
+		pagination
+		Values []*browseEntry `json:"values"`
+	} `json:"children"`
+}
+
+type browseEntry struct {
+	Path struct {
+		Name     string `json:"name"`
+		ToString string `json:"toString"`
+	} `json:"path"`
+	ContentID string `json:"contentId"`
+	Type      string `json:"type"`
+	Size      int    `json:"size"`
+}
+
+func convertBrowseEntryList(from []*browseEntry) []*scm.FileEntry {
+	to := []*scm.FileEntry{}
+	for _, v := range from {
+		to = append(to, convertBrowseEntry(v))
+	}
+	return to
+}
+
+func convertBrowseEntry(from *browseEntry) *scm.FileEntry {
+	to := &scm.FileEntry{
+		Name: from.Path.Name,
+		Path: from.Path.ToString,
+		Size: from.Size,
+		Sha:  from.ContentID,
+	}
+	switch from.Type {
+	case "DIRECTORY":
+		to.Type = "dir"
+	case "SUBMODULE":
+		to.Type = "submodule"
+	default:
+		to.Type = "file"
+	}
+	return to
+}
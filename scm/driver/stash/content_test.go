@@ -42,6 +42,62 @@ func TestContentFind(t *testing.T) {
 	}
 }
 
+func TestContentTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/files").
+		MatchParam("at", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/files.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Contents.Tree(context.Background(), "PRJ/my-repo", "master", true)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.FileEntry{}
+	raw, _ := ioutil.ReadFile("testdata/files.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentTree_NotRecursive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/browse").
+		MatchParam("at", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/browse.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.Contents.Tree(context.Background(), "PRJ/my-repo", "master", false)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.FileEntry{}
+	raw, _ := ioutil.ReadFile("testdata/browse.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if res.Truncated {
+		t.Errorf("Expect complete tree")
+	}
+}
+
 func TestContentCreate(t *testing.T) {
 	content := new(contentService)
 	_, err := content.Create(context.Background(), "atlassian/atlaskit", "README", nil)
@@ -7,10 +7,13 @@ package stash
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/diff"
 )
 
 // TODO(bradrydzewski) commit link is an empty string.
@@ -43,6 +46,31 @@ func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Resp
 	panic("implement me")
 }
 
+// IsAncestor lists the commits reachable from the ancestor but not
+// from the descendant, which is empty when ancestor is an ancestor.
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("from", ancestor)
+	params.Set("to", descendant)
+	params.Set("limit", "1")
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/compare/commits?%s", namespace, name, params.Encode())
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return len(out.Values) == 0, res, nil
+}
+
+func (s *gitService) CherryPick(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Revert(context.Context, string, string, string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, branch string) (*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?filterText=%s", namespace, name, branch)
@@ -67,6 +95,20 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+// FindDiff returns the raw patch of the commit.
+func (s *gitService) FindDiff(ctx context.Context, repo, ref string) ([]byte, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("until", ref)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/patch?%s", namespace, name, params.Encode())
+	body, res, err := s.client.stream(ctx, path)
+	if err != nil {
+		return nil, res, err
+	}
+	out, err := diff.Read(body)
+	return out, res, err
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, tag string) (*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags?filterText=%s", namespace, name, tag)
@@ -88,11 +130,34 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?%s", namespace, name, encodeListOptions(opts))
 	out := new(branches)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
 	if !out.pagination.LastPage.Bool {
 		res.Page.First = 1
 		res.Page.Next = opts.Page + 1
 	}
-	return convertBranchList(out), res, err
+	to := convertBranchList(out)
+	// the branch permissions are only visible to repository
+	// administrators. If they cannot be read the protection
+	// status is unknown and the branches are returned as
+	// unprotected.
+	if restrictions, err := s.listRestrictions(ctx, repo); err == nil {
+		for _, ref := range to {
+			ref.Protected = restrictions.match(ref.Path)
+		}
+	}
+	return to, res, nil
+}
+
+// listRestrictions returns the branch permissions of the
+// repository.
+func (s *gitService) listRestrictions(ctx context.Context, repo string) (*restrictions, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions?limit=1000", namespace, name)
+	out := new(restrictions)
+	_, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, err
 }
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
@@ -132,6 +197,73 @@ type branch struct {
 	IsDefault       bool   `json:"isDefault"`
 }
 
+type restrictions struct {
+	pagination
+	Values []*restriction `json:"values"`
+}
+
+type restriction struct {
+	ID      int    `json:"id"`
+	Type    string `json:"type"`
+	Matcher struct {
+		ID   string `json:"id"`
+		Type struct {
+			ID string `json:"id"`
+		} `json:"type"`
+	} `json:"matcher"`
+}
+
+// match returns true if a branch or pattern restriction applies
+// to the fully qualified branch name. Branching model matchers
+// are not evaluated.
+func (r *restrictions) match(ref string) bool {
+	for _, v := range r.Values {
+		switch v.Matcher.Type.ID {
+		case "BRANCH":
+			if v.Matcher.ID == ref {
+				return true
+			}
+		case "PATTERN":
+			if matchPattern(v.Matcher.ID, scm.TrimRef(ref)) || matchPattern(v.Matcher.ID, ref) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether name matches the Ant style
+// branch permission pattern. A * matches any characters except
+// a slash, ? matches a single character other than a slash
+// and ** matches across slashes. A trailing slash matches
+// everything below it.
+func matchPattern(pattern, name string) bool {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '*' && strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(.*/)?")
+			i += 2
+		case c == '*' && strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case c == '*':
+			expr.WriteString("[^/]*")
+		case c == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	ok, _ := regexp.MatchString(expr.String(), name)
+	return ok
+}
+
 type commits struct {
 	pagination
 	Values []*commit `json:"values"`
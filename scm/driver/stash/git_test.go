@@ -41,6 +41,74 @@ func TestGitFindCommit(t *testing.T) {
 	}
 }
 
+func TestGitFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/patch").
+		MatchParam("until", "131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.patch")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.FindDiff(context.Background(), "PRJ/my-repo", "131cb13f4aed12e725177bc4b7c28db67839bf9f")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/commit.patch")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/compare/commits").
+		MatchParam("from", "feature").
+		MatchParam("to", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare_commits_empty.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.IsAncestor(context.Background(), "PRJ/my-repo", "feature", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Expect feature to be an ancestor of master")
+	}
+}
+
+func TestGitIsAncestor_NotMerged(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/compare/commits").
+		MatchParam("from", "feature").
+		MatchParam("to", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare_commits.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.IsAncestor(context.Background(), "PRJ/my-repo", "feature", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got {
+		t.Errorf("Expect feature not to be an ancestor of master")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -111,6 +179,12 @@ func TestGitListBranches(t *testing.T) {
 		Type("application/json").
 		File("testdata/branches.json")
 
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/restrictions.json")
+
 	client, _ := New("http://example.com:7990")
 	got, _, err := client.Git.ListBranches(context.Background(), "PRJ/my-repo", scm.ListOptions{Page: 1, Size: 30})
 	if err != nil {
@@ -129,6 +203,105 @@ func TestGitListBranches(t *testing.T) {
 	// t.Run("Page", testPage(res))
 }
 
+func TestGitListBranches_NoRestrictions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branches.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(404).
+		Type("application/json").
+		File("testdata/error.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.ListBranches(context.Background(), "PRJ/my-repo", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got[0].Protected {
+		t.Errorf("Expect branch not protected")
+	}
+}
+
+func TestGitListBranches_RestrictionsForbidden(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branches.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(403).
+		Type("application/json").
+		File("testdata/error.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.ListBranches(context.Background(), "PRJ/my-repo", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) == 0 {
+		t.Errorf("Expect branches returned")
+		return
+	}
+	if got[0].Protected {
+		t.Errorf("Expect branch not protected")
+	}
+}
+
+func Test_restrictionsMatch(t *testing.T) {
+	out := new(restrictions)
+	raw, _ := ioutil.ReadFile("testdata/restrictions.json")
+	json.Unmarshal(raw, out)
+
+	tests := []struct {
+		ref  string
+		want bool
+	}{
+		{"refs/heads/master", true},
+		{"refs/heads/release/1.0", true},
+		{"refs/heads/feature/login", false},
+		{"refs/heads/release/1.0/hotfix", false},
+	}
+	for _, test := range tests {
+		if got := out.match(test.ref); got != test.want {
+			t.Errorf("Want protected %v for %s, got %v", test.want, test.ref, got)
+		}
+	}
+}
+
+func Test_matchPattern(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"release/*", "release/1.0", true},
+		{"release/*", "release/1.0/hotfix", false},
+		{"release/**", "release/1.0/hotfix", true},
+		{"release/", "release/1.0/hotfix", true},
+		{"**/hotfix", "hotfix", true},
+		{"**/hotfix", "release/1.0/hotfix", true},
+		{"feature-?", "feature-a", true},
+		{"feature-?", "feature-ab", false},
+		{"v1.*", "v1x2", false},
+	}
+	for _, test := range tests {
+		if got := matchPattern(test.pattern, test.name); got != test.want {
+			t.Errorf("Want match %v for %s against %s, got %v", test.want, test.name, test.pattern, got)
+		}
+	}
+}
+
 func TestGitListTags(t *testing.T) {
 	defer gock.Off()
 
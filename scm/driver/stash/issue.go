@@ -19,6 +19,10 @@ func (s *issueService) AssignIssue(ctx context.Context, repo string, number int,
 	panic("implement me")
 }
 
+func (s *issueService) SetAssignees(context.Context, string, int, []string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *issueService) UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -44,18 +48,75 @@ func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// FindComment returns the pull request comment by id. Stash has
+// no issue tracker, so issue comments are pull request comments.
+func (s *issueService) FindComment(ctx context.Context, repo string, number, id int) (*scm.Comment, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertPullRequestComment(out), res, err
 }
 
+// List returns the pull requests as issues. Stash has no issue
+// tracker, so Since is matched against the pull request update
+// time. The filter is applied to each page, which can then hold
+// fewer results than the page size. The sort, label, assignee and
+// creator filters are not supported and are ignored.
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	pulls := &pullService{s.client}
+	out, res, err := pulls.List(ctx, repo, scm.PullRequestListOptions{
+		Page:      opts.Page,
+		Size:      opts.Size,
+		Open:      opts.Open,
+		Closed:    opts.Closed,
+		Direction: opts.Direction,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	issues := []*scm.Issue{}
+	for _, pr := range out {
+		if pr.Updated.Before(opts.Since) {
+			continue
+		}
+		issues = append(issues, convertPullRequestIssue(pr))
+	}
+	return issues, res, nil
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// ListAllComments returns the comments of the pull request
+// activity, including the comments anchored to a file, listing
+// all pages. Bitbucket Server has no issue tracker, so the number
+// is the pull request number.
+func (s *issueService) ListAllComments(ctx context.Context, repo string, number int) ([]*scm.Comment, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	all := []*scm.Comment{}
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+		out := new(activities)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, convertActivityCommentList(out)...)
+		if out.pagination.LastPage.Bool {
+			scm.SortComments(all)
+			return all, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			scm.SortComments(all)
+			return all, res, err
+		}
+		opts.Page++
+	}
+}
+
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -77,10 +138,43 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return nil, scm.ErrNotSupported
 }
 
+// Reopen reopens a declined pull request. Bitbucket Server
+// does not have issues, and pull requests share the issue
+// number space in the scm abstraction.
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/reopen", namespace, name, number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) LockWithOptions(ctx context.Context, repo string, number int, opts scm.LockOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *issueService) Transfer(ctx context.Context, repo string, number int, targetRepo string) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// helper function to convert a pull request to an issue.
+func convertPullRequestIssue(from *scm.PullRequest) *scm.Issue {
+	return &scm.Issue{
+		Number:      from.Number,
+		Title:       from.Title,
+		Body:        from.Body,
+		Link:        from.Link,
+		State:       from.State,
+		Closed:      from.Closed,
+		Author:      from.Author,
+		PullRequest: true,
+		Created:     from.Created,
+		Updated:     from.Updated,
+	}
+}
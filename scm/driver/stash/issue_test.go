@@ -6,9 +6,15 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestIssueFind(t *testing.T) {
@@ -19,16 +25,76 @@ func TestIssueFind(t *testing.T) {
 }
 
 func TestIssueCommentFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.FindComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Issues.FindComment(context.Background(), "PRJ/my-repo", 1, 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/pr_comment.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueList(t *testing.T) {
-	_, _, err := NewDefault().Issues.List(context.Background(), "", scm.IssueListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Issues.List(context.Background(), "PRJ/my-repo", scm.IssueListOptions{
+		Since: time.Date(2018, time.July, 5, 5, 1, 10, 0, time.UTC),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if len(got) != 1 {
+		t.Errorf("Want 1 issue, got %d", len(got))
+		return
+	}
+	if !got[0].PullRequest || got[0].Number != 1 || got[0].Title != "Updated Files" {
+		t.Errorf("Want pull request 1 as an issue, got %+v", got[0])
+	}
+}
+
+func TestIssueList_Since(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Issues.List(context.Background(), "PRJ/my-repo", scm.IssueListOptions{
+		Since: time.Date(2018, time.July, 5, 5, 1, 11, 0, time.UTC),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Want pull requests updated before Since to be skipped, got %d", len(got))
 	}
 }
 
@@ -39,6 +105,33 @@ func TestIssueListComments(t *testing.T) {
 	}
 }
 
+func TestIssueListAllComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		MatchParam("limit", "100").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_activities_newest.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Issues.ListAllComments(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/pr_activities_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestIssueCreate(t *testing.T) {
 	_, _, err := NewDefault().Issues.Create(context.Background(), "", &scm.IssueInput{})
 	if err != scm.ErrNotSupported {
@@ -69,6 +162,22 @@ func TestIssueClose(t *testing.T) {
 	}
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/reopen").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Issues.Reopen(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueLock(t *testing.T) {
 	_, err := NewDefault().Issues.Lock(context.Background(), "", 0)
 	if err != scm.ErrNotSupported {
@@ -49,3 +49,7 @@ func (s *organizationService) Find(ctx context.Context, name string) (*scm.Organ
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *organizationService) ListAllHooks(context.Context, string) (map[string][]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/diff"
 )
 
 type pullService struct {
@@ -33,9 +34,21 @@ func (s *pullService) FindComment(ctx context.Context, repo string, number int,
 	return convertPullRequestComment(out), res, err
 }
 
+// FindDiff returns the raw unified diff of the pull request.
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) ([]byte, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d.diff", namespace, name, number)
+	body, res, err := s.client.stream(ctx, path)
+	if err != nil {
+		return nil, res, err
+	}
+	out, err := diff.Read(body)
+	return out, res, err
+}
+
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
-	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests", namespace, name)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests?%s", namespace, name, encodePullRequestListOptions(opts))
 	out := new(pullRequests)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	if !out.pagination.LastPage.Bool {
@@ -45,6 +58,18 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequests(out), res, err
 }
 
+func (s *pullService) Create(context.Context, string, *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListMine(ctx context.Context, opts scm.ListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListForCommit(context.Context, string, string) ([]*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/changes", namespace, name, number)
@@ -89,6 +114,45 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) Rebase(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// FindCombinedStatus returns the combined status of the pull
+// request head commit.
+func (s *pullService) FindCombinedStatus(ctx context.Context, repo string, number int) (*scm.CombinedStatus, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.client.Repositories.FindCombinedStatus(ctx, repo, pr.Sha)
+}
+
+func (s *pullService) ListLinkedIssues(context.Context, string, int) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ApprovalCount returns the number of reviewers and participants
+// approving the pull request. The required approvals are enforced
+// by merge checks that are not exposed with the pull request, so
+// the required count is always zero.
+func (s *pullService) ApprovalCount(ctx context.Context, repo string, number int) (*scm.Approvals, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pullRequestApprovals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := new(scm.Approvals)
+	for _, v := range append(out.Reviewers, out.Participants...) {
+		if v.Approved {
+			to.Approved++
+		}
+	}
+	return to, res, nil
+}
+
 func (s *pullService) CreateComment(ctx context.Context, repo string, number int, in *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	input := pullRequestCommentInput{Text: in.Body}
 	namespace, name := scm.Split(repo)
@@ -156,6 +220,20 @@ type pullRequest struct {
 	} `json:"links"`
 }
 
+// pullRequestApprovals decodes the approval status of the pull
+// request reviewers and participants.
+type pullRequestApprovals struct {
+	Reviewers    []pullRequestParticipant `json:"reviewers"`
+	Participants []pullRequestParticipant `json:"participants"`
+}
+
+type pullRequestParticipant struct {
+	User     user   `json:"user"`
+	Role     string `json:"role"`
+	Approved bool   `json:"approved"`
+	Status   string `json:"status"`
+}
+
 type pullRequests struct {
 	pagination
 	Values []*pullRequest `json:"values"`
@@ -227,6 +305,7 @@ type pullRequestComment struct {
 	} `json:"author"`
 	CreatedDate         int64                `json:"createdDate"`
 	UpdatedDate         int64                `json:"updatedDate"`
+	ThreadResolved      bool                 `json:"threadResolved"`
 	Comments            []pullRequestComment `json:"comments"`
 	Tasks               []interface{}        `json:"tasks"`
 	PermittedOperations struct {
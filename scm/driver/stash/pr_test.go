@@ -41,6 +41,41 @@ func TestPullFind(t *testing.T) {
 	}
 }
 
+func TestPullFindCombinedStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Get("rest/build-status/1.0/commits/131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Reply(200).
+		Type("application/json").
+		File("testdata/build_statuses.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.FindCombinedStatus(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CombinedStatus)
+	raw, _ := ioutil.ReadFile("testdata/build_statuses.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
 func TestPullFindComment(t *testing.T) {
 	defer gock.Off()
 
@@ -91,6 +126,47 @@ func TestPullList(t *testing.T) {
 	}
 }
 
+func TestPullListMine(t *testing.T) {
+	_, _, err := NewDefault().PullRequests.ListMine(context.Background(), scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullListForCommit(t *testing.T) {
+	_, _, err := NewDefault().PullRequests.ListForCommit(context.Background(), "PRJ/my-repo", "131cb13f4aed12e725177bc4b7c28db67839bf9f")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullList_Base(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests").
+		MatchParam("state", "OPEN").
+		MatchParam("at", "refs/heads/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.List(context.Background(), "PRJ/my-repo", scm.PullRequestListOptions{State: "open", Base: "master"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/prs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullListChanges(t *testing.T) {
 	defer gock.Off()
 
@@ -176,3 +252,53 @@ func TestPullCreateComment(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1.diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/pr.diff")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.FindDiff(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want, _ := ioutil.ReadFile("testdata/pr.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullApprovalCount(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_approvals.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.PullRequests.ApprovalCount(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Approvals{Approved: 2}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -5,17 +5,23 @@
 package stash
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/batch"
 )
 
 type repository struct {
 	Slug          string `json:"slug"`
-	ID            int    `json:"id"`
+	ID            int64  `json:"id"`
 	Name          string `json:"name"`
 	ScmID         string `json:"scmId"`
 	State         string `json:"state"`
@@ -23,7 +29,7 @@ type repository struct {
 	Forkable      bool   `json:"forkable"`
 	Project       struct {
 		Key    string `json:"key"`
-		ID     int    `json:"id"`
+		ID     int64  `json:"id"`
 		Name   string `json:"name"`
 		Public bool   `json:"public"`
 		Type   string `json:"type"`
@@ -62,7 +68,7 @@ type hooks struct {
 }
 
 type hook struct {
-	ID          int      `json:"id"`
+	ID          int64    `json:"id"`
 	Name        string   `json:"name"`
 	CreatedDate int64    `json:"createdDate"`
 	UpdatedDate int64    `json:"updatedDate"`
@@ -85,11 +91,18 @@ type hookInput struct {
 }
 
 type status struct {
-	State string `json:"state"`
-	Key   string `json:"key"`
-	Name  string `json:"name"`
-	URL   string `json:"url"`
-	Desc  string `json:"description"`
+	State       string `json:"state"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Desc        string `json:"description"`
+	DateAdded   int64  `json:"dateAdded,omitempty"`
+	UpdatedDate int64  `json:"updatedDate,omitempty"`
+}
+
+type statuses struct {
+	pagination
+	Values []*status `json:"values"`
 }
 
 type participants struct {
@@ -102,12 +115,23 @@ type participant struct {
 	Permission string `json:"permission"`
 }
 
+// maxStatusLabelLength is the maximum length of a build status
+// key accepted by Bitbucket Server.
+const maxStatusLabelLength = 255
+
 type repositoryService struct {
 	client *wrapper
 }
 
+// FindCombinedStatus returns the combined build status of the
+// commit. The build status API is not scoped to the repository
+// and has no combined state, so the state is combined from the
+// build statuses.
 func (s *repositoryService) FindCombinedStatus(ctx context.Context, repo, ref string) (*scm.CombinedStatus, *scm.Response, error) {
-	panic("implement me")
+	path := fmt.Sprintf("rest/build-status/1.0/commits/%s?limit=100", ref)
+	out := new(statuses)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCombinedStatus(ref, out), res, err
 }
 
 func (s *repositoryService) FindUserPermission(ctx context.Context, repo string, user string) (string, *scm.Response, error) {
@@ -115,32 +139,160 @@ func (s *repositoryService) FindUserPermission(ctx context.Context, repo string,
 }
 
 func (s *repositoryService) IsCollaborator(ctx context.Context, repo, user string) (bool, *scm.Response, error) {
-	users, resp, err := s.ListCollaborators(ctx, repo)
-	if err != nil {
-		return false, resp, err
-	}
-	for _, u := range users {
-		if u.Name == user || u.Login == user {
-			return true, resp, err
+	opts := scm.ListOptions{Page: 1, Size: 1000}
+	for {
+		users, res, err := s.ListCollaborators(ctx, repo, opts)
+		if err != nil {
+			return false, res, err
 		}
+		for _, u := range users {
+			if u.Name == user || u.Login == user {
+				return true, res, nil
+			}
+		}
+		if res.Page.Next == 0 {
+			return false, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return false, res, err
+		}
+		opts.Page = res.Page.Next
 	}
-	return false, resp, err
 }
 
-func (s *repositoryService) ListCollaborators(ctx context.Context, repo string) ([]scm.User, *scm.Response, error) {
+// ListCollaborators lists the users granted a repository
+// permission. A page of up to 1000 users is requested when the
+// page size is not set.
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
+	collaborators, res, err := s.listParticipants(ctx, repo, opts)
+	users := []scm.User{}
+	for _, c := range collaborators {
+		users = append(users, c.User)
+	}
+	return users, res, err
+}
+
+// ListCollaboratorsWithPermission lists the users granted a
+// repository permission, with their permission level. A page of
+// up to 1000 users is requested when the page size is not set.
+func (s *repositoryService) ListCollaboratorsWithPermission(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return s.listParticipants(ctx, repo, opts)
+}
+
+func (s *repositoryService) ListContributors(context.Context, string, scm.ListOptions) ([]*scm.Contributor, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) listParticipants(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
-	opts := scm.ListOptions{
-		Size: 1000,
+	if opts.Page == 0 {
+		opts.Page = 1
+	}
+	if opts.Size == 0 {
+		opts.Size = 1000
 	}
-	//path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/participants?role=PARTICIPANT&%s", namespace, name, encodeListOptions(opts))
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/users?%s", namespace, name, encodeListOptions(opts))
 	out := new(participants)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
 	if !out.pagination.LastPage.Bool {
 		res.Page.First = 1
 		res.Page.Next = opts.Page + 1
 	}
-	return convertParticipants(out), res, err
+	return convertParticipants(out), res, nil
+}
+
+// AddCollaborator grants the user the repository permission
+// matching the given permission level.
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("name", user)
+	params.Set("permission", convertFromPermission(permission))
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/users?%s", namespace, name, params.Encode())
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return false, res, err
+}
+
+// RemoveCollaborator revokes all repository permissions from the user.
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, user string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("name", user)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/users?%s", namespace, name, params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) ListInvitations(context.Context, string, scm.ListOptions) ([]*scm.Invitation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteInvitation(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UploadAttachment(context.Context, string, string, io.Reader) (*scm.Attachment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Create(context.Context, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) CreateFromTemplate(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Star(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Unstar(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetArchived(context.Context, string, bool) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) TriggerPipeline(context.Context, string, string, map[string]string) (*scm.Pipeline, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DispatchWorkflow(context.Context, string, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Dispatch(context.Context, string, string, map[string]interface{}) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListSecrets(context.Context, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) SetSecret(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteSecret(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DownloadArchive(ctx context.Context, repo, ref, format string) (io.ReadCloser, *scm.Response, error) {
+	switch format {
+	case scm.ArchiveFormatTarGz, scm.ArchiveFormatZip:
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("at", ref)
+	params.Set("format", format)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/archive?%s", namespace, name, params.Encode())
+	return s.client.stream(ctx, path)
 }
 
 func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
@@ -157,6 +309,25 @@ func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Reposit
 	return convertRepository(out), res, err
 }
 
+func (s *repositoryService) FindWithViewerState(context.Context, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Exists returns true if the repository exists and is visible
+// to the authenticated user. Bitbucket Server does not support
+// HEAD requests for the repository resource, so a GET is issued.
+func (s *repositoryService) Exists(ctx context.Context, repo string) (bool, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	res, err := s.client.do(ctx, "GET", path, nil, nil)
+	if err == scm.ErrNotFound {
+		return false, res, nil
+	} else if err != nil {
+		return false, res, err
+	}
+	return true, res, nil
+}
+
 // FindHook returns a repository hook.
 func (s *repositoryService) FindHook(ctx context.Context, repo string, id string) (*scm.Hook, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
@@ -207,7 +378,9 @@ func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Pe
 	}, nil, nil
 }
 
-// List returns the user repository list.
+// List returns the user repository list. The default branch is
+// not included in the list response, so the Branch of each
+// repository is empty; use Find to get the repository branch.
 func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("rest/api/1.0/repos?%s", encodeListRoleOptions(opts))
 	out := new(repositories)
@@ -219,6 +392,13 @@ func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*
 	return convertRepositoryList(out), res, err
 }
 
+func (s *repositoryService) ListWithOptions(ctx context.Context, opts scm.RepositoryListOptions) ([]*scm.Repository, *scm.Response, error) {
+	if opts.Affiliation != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	return s.List(ctx, scm.ListOptions{Page: opts.Page, Size: opts.Size})
+}
+
 // listWrite returns the user repository list.
 func (s *repositoryService) listWrite(ctx context.Context, repo string) ([]*scm.Repository, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
@@ -264,8 +444,13 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
-// CreateStatus creates a new commit status.
+// CreateStatus creates a new commit status. Older versions of
+// Bitbucket Server respond with no content, in which case the
+// status is converted from the input.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+	if err := scm.CheckStatusLabel(input.Label, maxStatusLabelLength); err != nil {
+		return nil, nil, err
+	}
 	path := fmt.Sprintf("rest/build-status/1.0/commits/%s", ref)
 	in := status{
 		State: convertFromState(input.State),
@@ -274,13 +459,39 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 		URL:   input.Target,
 		Desc:  input.Desc,
 	}
-	res, err := s.client.do(ctx, "POST", path, in, nil)
-	return &scm.Status{
-		State:  input.State,
-		Label:  input.Label,
-		Desc:   input.Desc,
-		Target: input.Target,
-	}, res, err
+	buf := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "POST", path, in, buf)
+	if err != nil {
+		return nil, res, err
+	}
+	if buf.Len() == 0 {
+		return &scm.Status{
+			State:  input.State,
+			Label:  input.Label,
+			Desc:   input.Desc,
+			Target: input.Target,
+		}, res, nil
+	}
+	out := new(status)
+	if err := json.Unmarshal(buf.Bytes(), out); err != nil {
+		return nil, res, err
+	}
+	return convertStatus(out), res, nil
+}
+
+func (s *repositoryService) CreateStatuses(ctx context.Context, repo, ref string, inputs []*scm.StatusInput) ([]*scm.Status, *scm.Response, error) {
+	statuses, err := batch.CreateStatuses(ctx, inputs, func(ctx context.Context, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+		return s.CreateStatus(ctx, repo, ref, input)
+	})
+	return statuses, nil, err
+}
+
+// DeleteStatus deletes the build status with the given key.
+func (s *repositoryService) DeleteStatus(ctx context.Context, repo, ref, key string) (*scm.Response, error) {
+	params := url.Values{}
+	params.Set("key", key)
+	path := fmt.Sprintf("rest/build-status/1.0/commits/%s?%s", ref, params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 // DeleteHook deletes a repository webhook.
@@ -291,11 +502,16 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 }
 
 // helper function to convert from the gogs repository list to
-// the common repository structure.
+// the common repository structure. The list endpoints do not
+// include the default branch, so Branch is left empty to report
+// it as unknown rather than assuming master, as the single
+// repository conversion does.
 func convertRepositoryList(from *repositories) []*scm.Repository {
 	to := []*scm.Repository{}
 	for _, v := range from.Values {
-		to = append(to, convertRepository(v))
+		repo := convertRepository(v)
+		repo.Branch = ""
+		to = append(to, repo)
 	}
 	return to
 }
@@ -304,7 +520,7 @@ func convertRepositoryList(from *repositories) []*scm.Repository {
 // to the common repository structure.
 func convertRepository(from *repository) *scm.Repository {
 	return &scm.Repository{
-		ID:        strconv.Itoa(from.ID),
+		ID:        strconv.FormatInt(from.ID, 10),
 		Name:      from.Slug,
 		Namespace: from.Project.Key,
 		Link:      extractSelfLink(from.Links.Self),
@@ -312,9 +528,18 @@ func convertRepository(from *repository) *scm.Repository {
 		Private:   !from.Public,
 		CloneSSH:  extractLink(from.Links.Clone, "ssh"),
 		Clone:     anonymizeLink(extractLink(from.Links.Clone, "http")),
+		Personal:  isPersonal(from.Project.Key),
 	}
 }
 
+// helper function reports whether the project key is the key of
+// a personal project. Personal project keys are the user slug
+// with a ~ prefix, which is kept in the repository namespace as
+// the api paths require it to address a personal repository.
+func isPersonal(key string) bool {
+	return strings.HasPrefix(key, "~")
+}
+
 func extractLink(links []link, name string) (href string) {
 	for _, link := range links {
 		if link.Name == name {
@@ -350,11 +575,12 @@ func convertHookList(from *hooks) []*scm.Hook {
 
 func convertHook(from *hook) *scm.Hook {
 	return &scm.Hook{
-		ID:     strconv.Itoa(from.ID),
+		ID:     strconv.FormatInt(from.ID, 10),
 		Name:   from.Name,
 		Active: from.Active,
 		Target: from.URL,
 		Events: from.Events,
+		Parsed: parseHookEvents(from.Events),
 	}
 }
 
@@ -378,6 +604,25 @@ func convertHookEvents(from scm.HookEvents) []string {
 	return events
 }
 
+// helper function parses the native hook events into the
+// structured hook events.
+func parseHookEvents(from []string) scm.HookEvents {
+	var events scm.HookEvents
+	for _, event := range from {
+		switch event {
+		case "repo:refs_changed":
+			events.Push = true
+			events.Branch = true
+			events.Tag = true
+		case "pr:declined", "pr:modified", "pr:deleted", "pr:opened", "pr:merged":
+			events.PullRequest = true
+		case "pr:comment:added", "pr:comment:deleted", "pr:comment:edited":
+			events.PullRequestComment = true
+		}
+	}
+	return events
+}
+
 func convertFromState(from scm.State) string {
 	switch from {
 	case scm.StatePending, scm.StateRunning:
@@ -389,6 +634,30 @@ func convertFromState(from scm.State) string {
 	}
 }
 
+// helper function converts the repository permission to the
+// permission level, such as admin, write or read.
+func convertToPermission(from string) string {
+	switch from {
+	case "REPO_ADMIN":
+		return "admin"
+	case "REPO_WRITE":
+		return "write"
+	default:
+		return "read"
+	}
+}
+
+func convertFromPermission(from string) string {
+	switch from {
+	case "admin":
+		return "REPO_ADMIN"
+	case "maintain", "push", "write":
+		return "REPO_WRITE"
+	default:
+		return "REPO_READ"
+	}
+}
+
 func convertState(from string) scm.State {
 	switch from {
 	case "FAILED":
@@ -402,10 +671,45 @@ func convertState(from string) scm.State {
 	}
 }
 
-func convertParticipants(participants *participants) []scm.User {
-	answer := []scm.User{}
+func convertCombinedStatus(ref string, from *statuses) *scm.CombinedStatus {
+	to := &scm.CombinedStatus{Sha: ref}
+	states := []scm.State{}
+	for _, v := range from.Values {
+		status := convertStatus(v)
+		states = append(states, status.State)
+		to.Statuses = append(to.Statuses, status)
+	}
+	to.State = scm.CombineStates(states...)
+	scm.SortStatuses(to.Statuses)
+	return to
+}
+
+func convertStatus(from *status) *scm.Status {
+	to := &scm.Status{
+		State:  convertState(from.State),
+		Label:  from.Key,
+		Desc:   from.Desc,
+		Target: from.URL,
+	}
+	// older versions only report the date the status was
+	// added, which is also the date it was last updated.
+	if from.DateAdded != 0 {
+		to.Created = time.Unix(from.DateAdded/1000, 0)
+		to.Updated = to.Created
+	}
+	if from.UpdatedDate != 0 {
+		to.Updated = time.Unix(from.UpdatedDate/1000, 0)
+	}
+	return to
+}
+
+func convertParticipants(participants *participants) []*scm.Collaborator {
+	answer := []*scm.Collaborator{}
 	for _, p := range participants.Values {
-		answer = append(answer, *convertUser(&p.User))
+		answer = append(answer, &scm.Collaborator{
+			User:       *convertUser(&p.User),
+			Permission: convertToPermission(p.Permission),
+		})
 	}
 	return answer
 }
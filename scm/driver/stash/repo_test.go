@@ -7,7 +7,9 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -41,6 +43,43 @@ func TestRepositoryFind(t *testing.T) {
 	}
 }
 
+func TestRepositoryFind_Personal(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/~jcitizen/repos/my-repo").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_personal.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Find(context.Background(), "~jcitizen/my-repo")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo_personal.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	// the namespace must address the personal repository.
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/~JCITIZEN/repos/my-repo").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_personal.json")
+
+	_, _, err = client.Repositories.Find(context.Background(), scm.Join(got.Namespace, got.Name))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestRepositoryFind_NotFound(t *testing.T) {
 	defer gock.Off()
 
@@ -56,8 +95,48 @@ func TestRepositoryFind_NotFound(t *testing.T) {
 		t.Errorf("Expect not found message")
 	}
 
-	if got, want := err.Error(), "Project dev does not exist."; got != want {
-		t.Errorf("Want error message %q, got %q", want, got)
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %q, got %q", scm.ErrNotFound, err)
+	}
+}
+
+func TestRepositoryExists(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Exists(context.Background(), "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Expect repository exists")
+	}
+}
+
+func TestRepositoryExists_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/dev/repos/null").
+		Reply(404).
+		Type("application/json").
+		File("testdata/error.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Exists(context.Background(), "dev/null")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got {
+		t.Errorf("Expect repository does not exist")
 	}
 }
 
@@ -245,6 +324,28 @@ func TestRepositoryList(t *testing.T) {
 	}
 }
 
+func TestRepositoryList_Branch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/repos").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.List(context.Background(), scm.ListOptions{Page: 1, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, repo := range got {
+		if repo.Branch != "" {
+			t.Errorf("Want empty Branch for listed repository %s, got %q", repo.Name, repo.Branch)
+		}
+	}
+}
+
 func TestStatusList(t *testing.T) {
 	client, _ := New("http://example.com:7990")
 	_, _, err := client.Repositories.ListStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", scm.ListOptions{Size: 30, Page: 1})
@@ -267,12 +368,124 @@ func TestStatusCreate(t *testing.T) {
 		Target: "https://ci.example.com/1000/output",
 	}
 
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.CreateStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Status{
+		State:  scm.StateSuccess,
+		Label:  "continuous-integration/drone/pull",
+		Desc:   "Build has completed successfully",
+		Target: "https://ci.example.com/1000/output",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestStatusCreate_LabelTooLong(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/build-status/1.0/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(204)
+
+	in := &scm.StatusInput{
+		Desc:   "Build has completed successfully",
+		Label:  strings.Repeat("a", 256),
+		State:  scm.StateSuccess,
+		Target: "https://ci.example.com/1000/output",
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, res, err := client.Repositories.CreateStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", in)
+	if !errors.As(err, new(scm.StatusLabelTooLong)) {
+		t.Errorf("Expect StatusLabelTooLong error, got %v", err)
+	}
+	if res != nil || !gock.IsPending() {
+		t.Errorf("Expect the build status not to be sent")
+	}
+}
+
+func TestStatusCreate_Response(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/build-status/1.0/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(200).
+		Type("application/json").
+		File("testdata/status.json")
+
+	in := &scm.StatusInput{
+		Desc:   "Build has completed successfully",
+		Label:  "continuous-integration/drone/pull",
+		State:  scm.StateError,
+		Target: "https://ci.example.com/1000/output",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.CreateStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Status)
+	raw, _ := ioutil.ReadFile("testdata/status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestStatusCreate_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/build-status/1.0/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(400).
+		Type("application/json").
+		BodyString(`{"errors":[{"context":"key","message":"The key must not be empty","exceptionName":null}]}`)
+
+	in := &scm.StatusInput{
+		State:  scm.StateSuccess,
+		Target: "https://ci.example.com/1000/output",
+	}
+
 	client, _ := New("http://example.com:7990")
 	_, _, err := client.Repositories.CreateStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", in)
+	if err == nil {
+		t.Errorf("Expect error when the status is rejected")
+		return
+	}
+	if got, want := err.Error(), "The key must not be empty"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
+
+func TestStatusDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/build-status/1.0/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		MatchParam("key", "continuous-integration/drone/pull").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.DeleteStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", "continuous-integration/drone/pull")
 	if err != nil {
 		t.Error(err)
 		return
 	}
+	if !gock.IsDone() {
+		t.Errorf("Expect the build status delete request")
+	}
 }
 
 func TestRepositoryHookFind(t *testing.T) {
@@ -449,3 +662,240 @@ func TestConvertState(t *testing.T) {
 		}
 	}
 }
+
+func TestConvertFromPermission(t *testing.T) {
+	tests := []struct {
+		src string
+		dst string
+	}{
+		{src: "admin", dst: "REPO_ADMIN"},
+		{src: "maintain", dst: "REPO_WRITE"},
+		{src: "push", dst: "REPO_WRITE"},
+		{src: "write", dst: "REPO_WRITE"},
+		{src: "triage", dst: "REPO_READ"},
+		{src: "pull", dst: "REPO_READ"},
+	}
+	for _, test := range tests {
+		if got, want := convertFromPermission(test.src), test.dst; got != want {
+			t.Errorf("Want permission %s converted to %s, got %s", test.src, want, got)
+		}
+	}
+}
+
+func TestRepositoryAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("name", "jcitizen").
+		MatchParam("permission", "REPO_WRITE").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	invited, _, err := client.Repositories.AddCollaborator(context.Background(), "PRJ/my-repo", "jcitizen", "push")
+	if err != nil {
+		t.Error(err)
+	}
+	if invited {
+		t.Errorf("Expect permissions to be granted without an invitation")
+	}
+}
+
+func TestRepositoryRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("name", "jcitizen").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.RemoveCollaborator(context.Background(), "PRJ/my-repo", "jcitizen")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryListCollaboratorsWithPermission(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("limit", "1000").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListCollaboratorsWithPermission(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Collaborator{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryListCollaboratorsWithPermission_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("start", "2").
+		MatchParam("limit", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators_page2.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("limit", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators_page1.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := scm.ListAll(context.Background(), scm.ListOptions{Size: 2}, func(ctx context.Context, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+		return client.Repositories.ListCollaboratorsWithPermission(ctx, "PRJ/my-repo", opts)
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	logins := []string{}
+	for _, v := range got {
+		logins = append(logins, v.Login+":"+v.Permission)
+	}
+	want := []string{"jcitizen:admin", "mjones:write", "jsmith:read"}
+	if diff := cmp.Diff(logins, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect both pages to be requested")
+	}
+}
+
+func TestRepositoryListCollaborators(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("limit", "1000").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListCollaborators(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []scm.User{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators_users.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryListCollaborators_Paginated(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("limit", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators_page1.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("start", "2").
+		MatchParam("limit", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators_page2.json")
+
+	client, _ := New("http://example.com:7990")
+	opts := scm.ListOptions{Page: 1, Size: 2}
+	got, res, err := client.Repositories.ListCollaborators(context.Background(), "PRJ/my-repo", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := len(got), 2; got != want {
+		t.Errorf("Want %d collaborators on the first page, got %d", want, got)
+	}
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+
+	opts.Page = res.Page.Next
+	got, res, err = client.Repositories.ListCollaborators(context.Background(), "PRJ/my-repo", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := len(got), 1; got != want {
+		t.Errorf("Want %d collaborators on the last page, got %d", want, got)
+	}
+	if got, want := res.Page.Next, 0; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
+func TestRepositoryIsCollaborator_Paginated(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("start", "1000").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators_page2.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("limit", "1000").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators_page1.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.IsCollaborator(context.Background(), "PRJ/my-repo", "jsmith")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want user found on the second page to be a collaborator")
+	}
+}
+
+func TestParseHookEvents(t *testing.T) {
+	tests := []scm.HookEvents{
+		{Push: true, Branch: true, Tag: true},
+		{PullRequest: true},
+		{PullRequestComment: true},
+	}
+	for i, in := range tests {
+		got := parseHookEvents(convertHookEvents(in))
+		if diff := cmp.Diff(got, in); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
@@ -6,6 +6,9 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -22,10 +25,130 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 	return nil, nil, scm.ErrNotSupported
 }
 
+// ListComments returns the comments of the pull request activity
+// that are anchored to a file, skipping general comments and the
+// other activity types.
+func (s *reviewService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertActivityReviewList(out), res, nil
+}
+
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) EditComment(ctx context.Context, repo string, number, id int, body string) (*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int) ([]*scm.ReviewThread, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) UnresolveThread(ctx context.Context, repo string, number int, threadID string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) CreateCommentReply(ctx context.Context, repo string, number, inReplyTo int, body string) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type activities struct {
+	pagination
+	Values []*activity `json:"values"`
+}
+
+type activity struct {
+	ID            int                 `json:"id"`
+	Action        string              `json:"action"`
+	CommentAction string              `json:"commentAction"`
+	Comment       *pullRequestComment `json:"comment"`
+	CommentAnchor *commentAnchor      `json:"commentAnchor"`
+}
+
+type commentAnchor struct {
+	FromHash string `json:"fromHash"`
+	ToHash   string `json:"toHash"`
+	Line     int    `json:"line"`
+	LineType string `json:"lineType"`
+	FileType string `json:"fileType"`
+	Path     string `json:"path"`
+	SrcPath  string `json:"srcPath"`
+}
+
+// helper function to convert the pull request activity to a
+// list of review comments, skipping the activities that are
+// not comments anchored to a file.
+func convertActivityReviewList(from *activities) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from.Values {
+		if v.Action != "COMMENTED" || v.Comment == nil || v.CommentAnchor == nil {
+			continue
+		}
+		to = append(to, convertActivityReview(v))
+	}
+	return to
+}
+
+// helper function to convert the pull request activity to a
+// list of comments, skipping the activities that are not
+// comments.
+func convertActivityCommentList(from *activities) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range from.Values {
+		if v.Action != "COMMENTED" || v.Comment == nil {
+			continue
+		}
+		to = append(to, convertPullRequestComment(v.Comment))
+	}
+	return to
+}
+
+// helper function to convert the pull request activity to a
+// review comment. The activity comment is the root comment of
+// its thread, so the thread is identified by the comment id.
+func convertActivityReview(from *activity) *scm.Review {
+	return &scm.Review{
+		ID:      from.Comment.ID,
+		Body:    from.Comment.Text,
+		Path:    from.CommentAnchor.Path,
+		Sha:     from.CommentAnchor.ToHash,
+		Line:    from.CommentAnchor.Line,
+		Created: time.Unix(from.Comment.CreatedDate/1000, 0),
+		Updated: time.Unix(from.Comment.UpdatedDate/1000, 0),
+		Author: scm.User{
+			Login:  from.Comment.Author.Slug,
+			Name:   from.Comment.Author.DisplayName,
+			Email:  from.Comment.Author.EmailAddress,
+			Avatar: avatarLink(from.Comment.Author.EmailAddress),
+		},
+		ThreadID: strconv.Itoa(from.Comment.ID),
+		Resolved: from.Comment.ThreadResolved,
+	}
+}
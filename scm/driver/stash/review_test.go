@@ -6,9 +6,14 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -25,6 +30,33 @@ func TestReviewList(t *testing.T) {
 	}
 }
 
+func TestReviewListComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_activities.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ListComments(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/pr_activities.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestReviewCreate(t *testing.T) {
 	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
 	if err != scm.ErrNotSupported {
@@ -38,3 +70,24 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewEditComment(t *testing.T) {
+	_, _, err := NewDefault().Reviews.EditComment(context.Background(), "", 0, 0, "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewDeleteComment(t *testing.T) {
+	_, err := NewDefault().Reviews.DeleteComment(context.Background(), "", 0, 0)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	_, err := NewDefault().Reviews.ResolveThread(context.Background(), "", 0, "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
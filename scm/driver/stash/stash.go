@@ -10,10 +10,9 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net/url"
-	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/baseurl"
 	"github.com/jenkins-x/go-scm/scm/driver/internal/null"
 )
 
@@ -22,26 +21,34 @@ import (
 
 // New returns a new Stash API client.
 func New(uri string) (*scm.Client, error) {
-	base, err := url.Parse(uri)
+	base, err := baseurl.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(base.Path, "/") {
-		base.Path = base.Path + "/"
-	}
 	client := &wrapper{new(scm.Client)}
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverStash
 	client.Contents = &contentService{client}
+	client.Gists = &gistService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Misc = &miscService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Rulesets = &rulesetService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.SetDoFunc(client.do)
+	client.SetCapabilities(scm.Capabilities{
+		Statuses:      true,
+		Collaborators: true,
+		Archives:      true,
+	})
 	return client.Client, nil
 }
 
@@ -82,10 +89,15 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	// parse the bitbucket server request id.
+	res.ID = res.Header.Get("X-AREQUESTID")
+
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status == 401 {
 		return res, scm.ErrNotAuthorized
+	} else if res.Status == 404 {
+		return res, scm.ErrNotFound
 	} else if res.Status > 300 {
 		err := new(Error)
 		json.NewDecoder(res.Body).Decode(err)
@@ -118,6 +130,34 @@ type pagination struct {
 	NextPage null.Int  `json:"nextPageStart"`
 }
 
+// stream executes a GET request and returns the response body
+// without buffering it. The caller is responsible for closing
+// the returned body.
+func (c *wrapper) stream(ctx context.Context, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+		Stream: true,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Status == 401 {
+		res.Body.Close()
+		return nil, res, scm.ErrNotAuthorized
+	} else if res.Status == 404 {
+		res.Body.Close()
+		return nil, res, scm.ErrNotFound
+	} else if res.Status > 300 {
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		res.Body.Close()
+		return nil, res, err
+	}
+	return res.Body, res, nil
+}
+
 // Error represents a Stash error.
 type Error struct {
 	Errors []struct {
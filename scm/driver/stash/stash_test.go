@@ -5,7 +5,11 @@
 package stash
 
 import (
+	"context"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
 )
 
 func TestClient(t *testing.T) {
@@ -28,9 +32,52 @@ func TestClient_Base(t *testing.T) {
 	}
 }
 
+func TestClient_Capabilities(t *testing.T) {
+	want := scm.Capabilities{
+		Statuses:      true,
+		Collaborators: true,
+		Archives:      true,
+	}
+	if diff := cmp.Diff(NewDefault().Capabilities(), want); diff != "" {
+		t.Errorf("Unexpected Capabilities")
+		t.Log(diff)
+	}
+}
+
 func TestClient_Error(t *testing.T) {
 	_, err := New("http://a b.com/")
 	if err == nil {
 		t.Errorf("Expect error when invalid URL")
 	}
 }
+
+func TestClient_RateLimit(t *testing.T) {
+	_, _, err := NewDefault().RateLimit(context.Background())
+	if err != scm.ErrNotSupported {
+		t.Errorf("Want ErrNotSupported, got %v", err)
+	}
+}
+
+var mockHeaders = map[string]string{
+	"X-AREQUESTID": "@1VYAQ5Cx1044x26x0",
+}
+
+func testRequest(res *scm.Response) func(t *testing.T) {
+	return func(t *testing.T) {
+		if got, want := res.ID, "@1VYAQ5Cx1044x26x0"; got != want {
+			t.Errorf("Want X-AREQUESTID: %q, got %q", want, got)
+		}
+	}
+}
+
+// testRate verifies that no rate limit is reported, as Bitbucket
+// Server does not send rate limit headers with successful
+// responses.
+func testRate(res *scm.Response) func(t *testing.T) {
+	return func(t *testing.T) {
+		if diff := cmp.Diff(res.Rate, scm.Rate{}); diff != "" {
+			t.Errorf("Unexpected rate limit")
+			t.Log(diff)
+		}
+	}
+}
@@ -47,10 +47,18 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return email, res, err
 }
 
+func (s *userService) AcceptInvitation(context.Context, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListStarred(context.Context, scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type user struct {
 	Name         string `json:"name"`
 	EmailAddress string `json:"emailAddress"`
-	ID           int    `json:"id"`
+	ID           int64  `json:"id"`
 	DisplayName  string `json:"displayName"`
 	Active       bool   `json:"active"`
 	Slug         string `json:"slug"`
@@ -97,3 +97,11 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Want email %s, got %s", want, got)
 	}
 }
+
+func TestUserListStarred(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Users.ListStarred(context.Background(), scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
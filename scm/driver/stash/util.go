@@ -7,6 +7,7 @@ package stash
 import (
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -48,11 +49,34 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	if opts.Size != 0 {
 		params.Set("limit", strconv.Itoa(opts.Size))
 	}
-	if opts.Open && opts.Closed {
+	// the State option uses the Bitbucket Server state names,
+	// while the Open and Closed flags keep their original values.
+	switch {
+	case opts.State == "open":
+		params.Set("state", "OPEN")
+	case opts.State == "closed":
+		params.Set("state", "DECLINED")
+	case opts.State != "":
+		params.Set("state", strings.ToUpper(opts.State))
+	case opts.Open && opts.Closed:
 		params.Set("state", "all")
-	} else if opts.Closed {
+	case opts.Closed:
 		params.Set("state", "closed")
 	}
+	// the pull requests are filtered by a single branch, which
+	// is the target branch unless only the source is given.
+	if opts.Base != "" {
+		params.Set("at", "refs/heads/"+opts.Base)
+	} else if opts.Head != "" {
+		params.Set("at", "refs/heads/"+opts.Head)
+		params.Set("direction", "OUTGOING")
+	}
+	switch opts.Direction {
+	case "asc":
+		params.Set("order", "OLDEST")
+	case "desc":
+		params.Set("order", "NEWEST")
+	}
 	return params.Encode()
 }
 
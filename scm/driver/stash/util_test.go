@@ -46,3 +46,17 @@ func Test_encodePullRequestListOptions(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_encodePullRequestListOptions_Head(t *testing.T) {
+	t.Parallel()
+	opts := scm.PullRequestListOptions{
+		State:     "merged",
+		Head:      "feature",
+		Direction: "asc",
+	}
+	want := "at=refs%2Fheads%2Ffeature&direction=OUTGOING&order=OLDEST&state=MERGED"
+	got := encodePullRequestListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded pr list options %q, got %q", want, got)
+	}
+}
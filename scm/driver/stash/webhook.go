@@ -47,6 +47,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePullRequest(data)
 	case "pr:comment:added":
 		hook, err = s.parsePullRequestComment(data)
+	case "repo:modified":
+		hook, err = s.parseRepositoryModified(data)
+	case "mirror:repo_synchronized":
+		hook, err = s.parseMirrorSynchronized(data)
 	}
 	if err != nil {
 		return nil, err
@@ -61,16 +65,32 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	key, err := fn(hook)
 	if err != nil {
 		return hook, err
-	} else if key == "" {
-		return hook, nil
+	} else if key != "" {
+		sig := req.Header.Get("X-Hub-Signature")
+		if !hmac.ValidatePrefix(data, []byte(key), sig) {
+			return hook, scm.ErrSignatureInvalid
+		}
 	}
 
-	sig := req.Header.Get("X-Hub-Signature")
-	if !hmac.ValidatePrefix(data, []byte(key), sig) {
-		return hook, scm.ErrSignatureInvalid
-	}
+	return hook, s.validateAge(data)
+}
 
-	return hook, nil
+// validateAge rejects the delivery when the payload date, which
+// is covered by the payload signature, is older than the client
+// WebhookMaxAge.
+func (s *webhookService) validateAge(data []byte) error {
+	if s.client == nil || s.client.WebhookMaxAge == 0 {
+		return nil
+	}
+	dst := new(webhookDate)
+	if err := json.Unmarshal(data, dst); err != nil {
+		return err
+	}
+	date, err := time.Parse(webhookDateFormat, dst.Date)
+	if err != nil {
+		return err
+	}
+	return scm.CheckWebhookAge(date, s.client.WebhookMaxAge)
 }
 
 func (s *webhookService) parsePushHook(data []byte) (scm.Webhook, error) {
@@ -124,10 +144,45 @@ func (s *webhookService) parsePullRequestComment(data []byte) (scm.Webhook, erro
 
 }
 
+func (s *webhookService) parseRepositoryModified(data []byte) (scm.Webhook, error) {
+	src := new(repositoryModifiedHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.New == nil || src.Old == nil {
+		return nil, errors.New("Repository modified hook is missing the repository")
+	}
+	dst := convertRepositoryModifiedHook(src)
+	return dst, nil
+}
+
+func (s *webhookService) parseMirrorSynchronized(data []byte) (scm.Webhook, error) {
+	src := new(mirrorSynchronizedHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Repository == nil {
+		return nil, errors.New("Mirror hook is missing the repository")
+	}
+	dst := convertMirrorSynchronizedHook(src)
+	return dst, nil
+}
+
 //
 // native data structures
 //
 
+// webhookDateFormat is the layout of the payload date, which
+// is formatted as 2018-07-05T18:24:15+0000.
+const webhookDateFormat = "2006-01-02T15:04:05-0700"
+
+// webhookDate is the delivery date common to all payloads.
+type webhookDate struct {
+	Date string `json:"date"`
+}
+
 type pushHook struct {
 	EventKey   string      `json:"eventKey"`
 	Date       string      `json:"date"`
@@ -151,6 +206,26 @@ type pullRequestCommentHook struct {
 	Comment     *prComment   `json:"comment"`
 }
 
+type repositoryModifiedHook struct {
+	EventKey string      `json:"eventKey"`
+	Date     string      `json:"date"`
+	Actor    *user       `json:"actor"`
+	Old      *repository `json:"old"`
+	New      *repository `json:"new"`
+}
+
+type mirrorSynchronizedHook struct {
+	EventKey     string `json:"eventKey"`
+	Date         string `json:"date"`
+	MirrorServer struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"mirrorServer"`
+	SyncType   string      `json:"syncType"`
+	Repository *repository `json:"repository"`
+	Changes    []*change   `json:"changes"`
+}
+
 type prComment struct {
 	ID        int    `json:"id"`
 	Version   int    `json:"version"`
@@ -238,6 +313,31 @@ func convertBranchHook(src *pushHook) *scm.BranchHook {
 	return dst
 }
 
+//
+// repository hooks
+//
+
+func convertRepositoryModifiedHook(src *repositoryModifiedHook) *scm.RepositoryHook {
+	dst := &scm.RepositoryHook{
+		Action: scm.ActionUpdate,
+		Repo:   *convertRepository(src.New),
+		Old:    *convertRepository(src.Old),
+	}
+	if sender := convertUser(src.Actor); sender != nil {
+		dst.Sender = *sender
+	}
+	return dst
+}
+
+// the mirror synchronized payload does not include an actor
+// because the synchronization is performed by the mirror.
+func convertMirrorSynchronizedHook(src *mirrorSynchronizedHook) *scm.RepositoryHook {
+	return &scm.RepositoryHook{
+		Action: scm.ActionSync,
+		Repo:   *convertRepository(src.Repository),
+	}
+}
+
 func convertSignature(actor *user) scm.Signature {
 	return scm.Signature{
 		Name:   actor.DisplayName,
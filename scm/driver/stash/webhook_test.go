@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jenkins-x/go-scm/scm"
@@ -116,6 +117,27 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_comment.json.golden",
 			obj:    new(scm.PullRequestCommentHook),
 		},
+
+		//
+		// repository events
+		//
+
+		// repository renamed or moved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:modified",
+			before: "testdata/webhooks/repo_modified.json",
+			after:  "testdata/webhooks/repo_modified.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// mirror synchronized
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "mirror:repo_synchronized",
+			before: "testdata/webhooks/mirror_synchronized.json",
+			after:  "testdata/webhooks/mirror_synchronized.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -198,6 +220,37 @@ func TestWebhookVerified(t *testing.T) {
 	}
 }
 
+func TestWebhookExpired(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:refs_changed")
+	r.Header.Set("X-Hub-Signature", "sha256=c90565fa018f3039414a7929c9187a147f1ac463076961c4cf411e3c67c541f8")
+
+	client, _ := New("http://example.com:7990")
+	client.WebhookMaxAge = 5 * time.Minute
+	_, err := client.Webhooks.Parse(r, secretFunc)
+	if err != scm.ErrWebhookExpired {
+		t.Errorf("Expect expired webhook error, got %v", err)
+	}
+}
+
+func TestWebhookNotExpired(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	date := time.Now().UTC().Format(webhookDateFormat)
+	f = bytes.Replace(f, []byte("2018-07-05T18:22:00+0000"), []byte(date), 1)
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:refs_changed")
+
+	client, _ := New("http://example.com:7990")
+	client.WebhookMaxAge = 5 * time.Minute
+	_, err := client.Webhooks.Parse(r, func(scm.Webhook) (string, error) {
+		return "", nil
+	})
+	if err != nil {
+		t.Errorf("Expect webhook within the maximum age, got %v", err)
+	}
+}
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
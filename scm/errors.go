@@ -3,6 +3,7 @@ package scm
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 // MissingUsers is an error specifying the users that could not be unassigned.
@@ -60,3 +61,23 @@ type MissingHeader struct {
 func (e MissingHeader) Error() string {
 	return fmt.Sprintf("400 Bad Request: Missing Header: %s", e.Header)
 }
+
+// StatusLabelTooLong is returned when a commit status label
+// exceeds the maximum length accepted by the provider.
+type StatusLabelTooLong struct {
+	Label string
+	Max   int
+}
+
+func (e StatusLabelTooLong) Error() string {
+	return fmt.Sprintf("status label %q exceeds the maximum length of %d characters", e.Label, e.Max)
+}
+
+// CheckStatusLabel returns a StatusLabelTooLong error if the
+// commit status label is longer than max characters.
+func CheckStatusLabel(label string, max int) error {
+	if utf8.RuneCountInString(label) > max {
+		return StatusLabelTooLong{Label: label, Max: max}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/jenkins-x/go-scm/scm"
 	"github.com/jenkins-x/go-scm/scm/factory"
 	"github.com/jenkins-x/go-scm/scm/factory/examples/helpers"
 )
@@ -26,7 +27,9 @@ func main() {
 	fmt.Printf("listing collaborators on repository %s\n", repo)
 
 	ctx := context.Background()
-	users, _, err := client.Repositories.ListCollaborators(ctx, repo)
+	users, _, err := scm.ListAll(ctx, scm.ListOptions{}, func(ctx context.Context, opts scm.ListOptions) ([]scm.User, *scm.Response, error) {
+		return client.Repositories.ListCollaborators(ctx, repo, opts)
+	})
 	if err != nil {
 		helpers.Fail(err)
 		return
@@ -89,16 +89,38 @@ func NewClient(driver, serverURL, oauthToken string) (*scm.Client, error) {
 	return client, err
 }
 
+// NewClientWithBasicAuth creates a new client for a given driver and serverURL which
+// authenticates using a username and password. This is typically used with Bitbucket Server,
+// which accepts a password or personal access token with basic authentication.
+func NewClientWithBasicAuth(driver, serverURL, username, password string) (*scm.Client, error) {
+	client, err := NewClient(driver, serverURL, "")
+	if err != nil {
+		return client, err
+	}
+	client.Client = &http.Client{
+		Transport: &transport.BasicAuth{
+			Username: username,
+			Password: password,
+		},
+	}
+	return client, nil
+}
+
 // NewClientFromEnvironment creates a new client using environment variables $GIT_KIND, $GIT_SERVER, $GIT_TOKEN
-// defaulting to github if no $GIT_KIND or $GIT_SERVER
+// defaulting to github if no $GIT_KIND or $GIT_SERVER. For Bitbucket Server the $GIT_USER
+// variable can be specified to use basic authentication with $GIT_TOKEN as the password
 func NewClientFromEnvironment() (*scm.Client, error) {
 	driver := os.Getenv("GIT_KIND")
 	serverURL := os.Getenv("GIT_SERVER")
 	oauthToken := os.Getenv("GIT_TOKEN")
+	username := os.Getenv("GIT_USER")
 	if oauthToken == "" {
 		return nil, fmt.Errorf("No Git OAuth token specified for $GIT_TOKEN")
 	}
 	fmt.Printf("using driver: %s and serverURL: %s\n", driver, serverURL)
+	if username != "" && (driver == "stash" || driver == "bitbucketserver") {
+		return NewClientWithBasicAuth(driver, serverURL, username, oauthToken)
+	}
 	return NewClient(driver, serverURL, oauthToken)
 }
 
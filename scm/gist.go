@@ -0,0 +1,43 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// Gist represents a gist or snippet. Files maps the file
+	// names to the file content. The content is not populated
+	// when listing gists.
+	Gist struct {
+		ID          string
+		Description string
+		Public      bool
+		Files       map[string]string
+	}
+
+	// GistInput provides the input fields required for
+	// creating a gist.
+	GistInput struct {
+		Description string
+		Public      bool
+		Files       map[string]string
+	}
+
+	// GistService provides access to gist resources, which are
+	// known as snippets in GitLab.
+	GistService interface {
+		// Find returns the gist by id.
+		Find(context.Context, string) (*Gist, *Response, error)
+
+		// List returns the gists of the authenticated user.
+		List(context.Context, ListOptions) ([]*Gist, *Response, error)
+
+		// Create creates a new gist.
+		Create(context.Context, *GistInput) (*Gist, *Response, error)
+
+		// Delete deletes a gist.
+		Delete(context.Context, string) (*Response, error)
+	}
+)
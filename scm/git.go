@@ -18,6 +18,10 @@ type (
 		Name string
 		Path string
 		Sha  string
+
+		// Protected reports whether the branch is protected,
+		// when reported by the branch listing.
+		Protected bool
 	}
 
 	// CommitTree represents a commit tree
@@ -64,6 +68,9 @@ type (
 		// FindCommit finds a git commit by ref.
 		FindCommit(ctx context.Context, repo, ref string) (*Commit, *Response, error)
 
+		// FindDiff returns the raw unified diff of a git commit.
+		FindDiff(ctx context.Context, repo, ref string) ([]byte, *Response, error)
+
 		// FindTag finds a git tag by name.
 		FindTag(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
@@ -84,5 +91,20 @@ type (
 
 		// DeleteRef deletes the given ref
 		DeleteRef(ctx context.Context, repo, ref string) (*Response, error)
+
+		// IsAncestor reports whether the ancestor commit is
+		// reachable from the descendant commit, i.e. whether
+		// every commit of ancestor is contained in descendant.
+		// A ref is considered an ancestor of itself. Branch
+		// names, tags and commit shas are accepted.
+		IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *Response, error)
+
+		// CherryPick applies the commit to the branch and
+		// returns the new commit.
+		CherryPick(ctx context.Context, repo, sha, branch string) (*Commit, *Response, error)
+
+		// Revert reverts the commit on the branch and returns
+		// the new commit.
+		Revert(ctx context.Context, repo, sha, branch string) (*Commit, *Response, error)
 	}
 )
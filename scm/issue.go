@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 )
 
@@ -25,6 +26,15 @@ type (
 		PullRequest bool
 		Created     time.Time
 		Updated     time.Time
+
+		// BodyHTML is the rendered HTML body. It is only
+		// populated by the GitHub driver when the client is
+		// created with the WithBodyHTML option.
+		BodyHTML string
+
+		// Raw is the raw provider payload, populated when
+		// the client KeepRaw option is enabled.
+		Raw json.RawMessage
 	}
 
 	// IssueInput provides the input fields required for
@@ -32,6 +42,16 @@ type (
 	IssueInput struct {
 		Title string
 		Body  string
+
+		// Milestone is the milestone number, or the milestone
+		// id on GitLab and Gitea, and is ignored when zero.
+		Milestone int
+
+		// Assignees is the list of user logins to assign.
+		Assignees []string
+
+		// Labels is the list of label names to apply.
+		Labels []string
 	}
 
 	// IssueListOptions provides options for querying a
@@ -41,6 +61,40 @@ type (
 		Size   int
 		Open   bool
 		Closed bool
+
+		// Since limits the results to issues updated at or
+		// after the given time. Stash has no issue tracker
+		// and lists pull requests as issues, so Since is
+		// matched against the pull request update time.
+		Since time.Time
+
+		// Sort is the field used to order the results, one
+		// of created, updated or comments. The provider
+		// default is used when empty. GitLab does not
+		// support sorting by comments. The Gitea and Gogs
+		// issue apis cannot sort, so Sort and Direction are
+		// ignored by those drivers.
+		Sort string
+
+		// Direction is the sort direction, asc or desc.
+		Direction string
+
+		// Assignee and Creator filter the results by the
+		// login of the assigned user and issue author.
+		Assignee string
+		Creator  string
+
+		// Labels filters the results to issues that have
+		// all of the given labels.
+		Labels []string
+	}
+
+	// LockOptions provides optional fields used when
+	// locking an issue discussion.
+	LockOptions struct {
+		// Reason is the lock reason. Providers that do not
+		// support a lock reason ignore this value.
+		Reason string
 	}
 
 	// Comment represents a comment.
@@ -51,6 +105,15 @@ type (
 		Link    string
 		Created time.Time
 		Updated time.Time
+
+		// InReplyTo is the id of the parent comment when
+		// the comment is a reply in a review thread.
+		InReplyTo int
+
+		// BodyHTML is the rendered HTML body. It is only
+		// populated by the GitHub driver when the client is
+		// created with the WithBodyHTML option.
+		BodyHTML string
 	}
 
 	// CommentInput provides the input fields required for
@@ -65,6 +128,22 @@ type (
 		Actor   User
 		Label   Label
 		Created time.Time
+
+		// Source is the issue or pull request that referenced
+		// the issue, set for cross-referenced events.
+		Source *Issue
+
+		// Sha is the commit sha, set for committed events.
+		Sha string
+
+		// Rename is the title change, set for renamed events.
+		Rename *IssueRename
+	}
+
+	// IssueRename represents a change of an issue title.
+	IssueRename struct {
+		From string
+		To   string
 	}
 
 	// IssueService provides access to issue resources.
@@ -81,6 +160,11 @@ type (
 		// ListComments returns the issue comment list.
 		ListComments(context.Context, string, int, ListOptions) ([]*Comment, *Response, error)
 
+		// ListAllComments returns all the comments of the issue
+		// or pull request, merging the conversation comments
+		// and the inline review comments in created order.
+		ListAllComments(ctx context.Context, repo string, number int) ([]*Comment, *Response, error)
+
 		// ListLabels returns the labels on an issue
 		ListLabels(context.Context, string, int, ListOptions) ([]*Label, *Response, error)
 
@@ -99,12 +183,22 @@ type (
 		// Close closes an issue.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Reopen reopens a closed issue.
+		Reopen(ctx context.Context, repo string, number int) (*Response, error)
+
 		// Lock locks an issue discussion.
 		Lock(context.Context, string, int) (*Response, error)
 
+		// LockWithOptions locks an issue discussion with the
+		// provided options, such as the lock reason.
+		LockWithOptions(ctx context.Context, repo string, number int, opts LockOptions) (*Response, error)
+
 		// Unlock unlocks an issue discussion.
 		Unlock(context.Context, string, int) (*Response, error)
 
+		// Transfer moves an issue to the target repository.
+		Transfer(ctx context.Context, repo string, number int, targetRepo string) (*Issue, *Response, error)
+
 		// AddLabel adds a label to an issue
 		AddLabel(ctx context.Context, repo string, number int, label string) (*Response, error)
 
@@ -114,7 +208,21 @@ type (
 		// AssignIssue asigns one or more  users to an issue
 		AssignIssue(ctx context.Context, repo string, number int, logins []string) (*Response, error)
 
+		// SetAssignees replaces the issue assignees with the
+		// given logins, adding and removing assignees as needed,
+		// and returns the updated issue. An empty list removes
+		// all assignees.
+		SetAssignees(ctx context.Context, repo string, number int, logins []string) (*Issue, *Response, error)
+
 		// UnassignIssue removes the assignment of ne or more users on an issue
 		UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*Response, error)
 	}
 )
+
+// Lock reason values.
+const (
+	LockReasonOffTopic  = "off-topic"
+	LockReasonTooHeated = "too heated"
+	LockReasonResolved  = "resolved"
+	LockReasonSpam      = "spam"
+)
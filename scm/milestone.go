@@ -0,0 +1,61 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Milestone represents a repository milestone.
+	Milestone struct {
+		ID          int
+		Number      int
+		Title       string
+		Description string
+		State       string // open or closed
+		Link        string
+
+		// OpenIssues and ClosedIssues are the number of open
+		// and closed issues assigned to the milestone.
+		OpenIssues   int
+		ClosedIssues int
+
+		// DueDate is the milestone due date, or the zero time
+		// if the milestone has no due date.
+		DueDate time.Time
+		Created time.Time
+		Updated time.Time
+	}
+
+	// MilestoneListOptions provides options for querying a
+	// list of repository milestones.
+	MilestoneListOptions struct {
+		Page int
+		Size int
+
+		// State filters the milestones by state, either open,
+		// closed or all. Defaults to open when empty.
+		State string
+
+		// Sort is the field used to order the results, either
+		// due_on or completeness. GitLab does not support
+		// server side sorting, so the milestones are sorted
+		// by due date within each page, and completeness is
+		// not supported.
+		Sort string
+
+		// Direction is the sort direction, asc or desc.
+		Direction string
+	}
+
+	// MilestoneService provides access to repository milestone
+	// resources.
+	MilestoneService interface {
+		// List returns the repository milestones.
+		List(ctx context.Context, repo string, opts MilestoneListOptions) ([]*Milestone, *Response, error)
+	}
+)
@@ -0,0 +1,20 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+// MiscService provides access to provider resources that
+// are not tied to a repository, such as markdown rendering.
+type MiscService interface {
+	// RenderMarkdown renders the markdown text to HTML. If
+	// the repository is not empty, issue and pull request
+	// references are linked in the repository context.
+	RenderMarkdown(ctx context.Context, repo, text string) (string, *Response, error)
+
+	// ListEmojis returns the emojis supported by the
+	// provider, mapping the emoji name to the image url.
+	ListEmojis(ctx context.Context) (map[string]string, *Response, error)
+}
@@ -51,5 +51,11 @@ type (
 
 		// ListTeamMembers lists the members of a team with a given role
 		ListTeamMembers(ctx context.Context, id int, role string, ops ListOptions) ([]*TeamMember, *Response, error)
+
+		// ListAllHooks returns the organization webhooks and the
+		// webhooks of every organization repository. The hooks are
+		// keyed by the organization name and the repository full
+		// names.
+		ListAllHooks(ctx context.Context, org string) (map[string][]*Hook, *Response, error)
 	}
 )
@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 )
 
@@ -32,6 +33,10 @@ type (
 		Assignees []User
 		Created   time.Time
 		Updated   time.Time
+
+		// Raw is the raw provider payload, populated when
+		// the client KeepRaw option is enabled.
+		Raw json.RawMessage
 	}
 
 	// PullRequestListOptions provides options for querying
@@ -41,6 +46,38 @@ type (
 		Size   int
 		Open   bool
 		Closed bool
+
+		// State filters the pull requests by state, either
+		// open, closed or all. It takes precedence over the
+		// Open and Closed options when set.
+		State string
+
+		// Base filters the pull requests by target branch.
+		Base string
+
+		// Head filters the pull requests by source branch.
+		// GitHub expects the user:branch format.
+		Head string
+
+		// Sort is the sort field, either created or updated.
+		Sort string
+
+		// Direction is the sort direction, asc or desc.
+		Direction string
+	}
+
+	// PullRequestInput provides the input fields required
+	// for creating a pull request.
+	PullRequestInput struct {
+		Title  string
+		Body   string
+		Source string
+		Target string
+
+		// Assignees and Reviewers are the logins of the users
+		// to assign and to request a review from.
+		Assignees []string
+		Reviewers []string
 	}
 
 	// PullRequestBranch contains information about a particular branch in a PR.
@@ -63,6 +100,18 @@ type (
 		Sha       string
 	}
 
+	// Approvals summarizes the approvals of a pull request.
+	Approvals struct {
+		// Approved is the number of users currently
+		// approving the pull request.
+		Approved int
+
+		// Required is the number of approvals required
+		// before the pull request can be merged, or zero
+		// when the provider reports no requirement.
+		Required int
+	}
+
 	// PullRequestService provides access to pull request resources.
 	PullRequestService interface {
 		// Find returns the repository pull request by number.
@@ -71,9 +120,28 @@ type (
 		// FindComment returns the pull request comment by id.
 		FindComment(context.Context, string, int, int) (*Comment, *Response, error)
 
+		// FindDiff returns the raw unified diff of the pull request.
+		FindDiff(ctx context.Context, repo string, number int) ([]byte, *Response, error)
+
 		// Find returns the repository pull request list.
 		List(context.Context, string, PullRequestListOptions) ([]*PullRequest, *Response, error)
 
+		// Create creates a new pull request. The assignees and
+		// reviewers are set on a best-effort basis: if some
+		// cannot be set, the created pull request is returned
+		// together with an error describing the failures.
+		Create(ctx context.Context, repo string, input *PullRequestInput) (*PullRequest, *Response, error)
+
+		// ListMine returns the open pull requests created by
+		// the authenticated user across all repositories. The
+		// repository is reported in the Base.Repo field, and
+		// the head and base commits may not be populated.
+		ListMine(ctx context.Context, opts ListOptions) ([]*PullRequest, *Response, error)
+
+		// ListForCommit returns the pull requests associated
+		// with the commit sha.
+		ListForCommit(ctx context.Context, repo, sha string) ([]*PullRequest, *Response, error)
+
 		// ListChanges returns the pull request changeset.
 		ListChanges(context.Context, string, int, ListOptions) ([]*Change, *Response, error)
 
@@ -86,10 +154,27 @@ type (
 		// Close closes the repository pull request.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Rebase rebases the pull request source branch onto
+		// the target branch, and waits for the rebase to
+		// complete.
+		Rebase(ctx context.Context, repo string, number int) (*Response, error)
+
 		// CreateComment creates a new pull request comment.
 		CreateComment(context.Context, string, int, *CommentInput) (*Comment, *Response, error)
 
 		// DeleteComment deletes an pull request comment.
 		DeleteComment(context.Context, string, int, int) (*Response, error)
+
+		// FindCombinedStatus returns the combined status of
+		// the pull request head commit.
+		FindCombinedStatus(ctx context.Context, repo string, number int) (*CombinedStatus, *Response, error)
+
+		// ListLinkedIssues returns the issues that are closed
+		// when the pull request is merged.
+		ListLinkedIssues(ctx context.Context, repo string, number int) ([]*Issue, *Response, error)
+
+		// ApprovalCount returns the number of approvals the
+		// pull request has and the number it requires.
+		ApprovalCount(ctx context.Context, repo string, number int) (*Approvals, *Response, error)
 	}
 )
@@ -6,9 +6,24 @@ package scm
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"time"
 )
 
+// Repository affiliations used to filter repository lists.
+const (
+	AffiliationOwner              = "owner"
+	AffiliationCollaborator       = "collaborator"
+	AffiliationOrganizationMember = "organization_member"
+)
+
+// Repository archive formats.
+const (
+	ArchiveFormatTarGz = "tar.gz"
+	ArchiveFormatZip   = "zip"
+)
+
 type (
 	// Repository represents a git repository.
 	Repository struct {
@@ -24,6 +39,27 @@ type (
 		Link      string
 		Created   time.Time
 		Updated   time.Time
+
+		// Starred and Subscribed report whether the
+		// authenticated user stars and watches the
+		// repository. They are only populated by
+		// FindWithViewerState.
+		Starred    bool
+		Subscribed bool
+
+		// Personal reports whether the repository belongs
+		// to a user's personal namespace rather than to an
+		// organization, group or project.
+		Personal bool
+
+		// OpenIssues is the number of open issues. GitHub
+		// counts open pull requests as issues, so on GitHub
+		// the number includes the open pull requests.
+		OpenIssues int
+
+		// Raw is the raw provider payload, populated when
+		// the client KeepRaw option is enabled.
+		Raw json.RawMessage
 	}
 
 	// Perm represents a user's repository permissions.
@@ -33,14 +69,76 @@ type (
 		Admin bool
 	}
 
+	// RepositoryInput provides the input fields required
+	// for creating a repository.
+	RepositoryInput struct {
+		Namespace   string
+		Name        string
+		Description string
+		Private     bool
+
+		// AutoInit creates the repository with an initial
+		// commit. GitignoreTemplate and LicenseTemplate are
+		// the names of the templates added to that commit.
+		// These fields are ignored by providers that do not
+		// support them.
+		AutoInit          bool
+		GitignoreTemplate string
+		LicenseTemplate   string
+	}
+
+	// RepositoryListOptions provides options for querying
+	// a list of repositories.
+	RepositoryListOptions struct {
+		Page int
+		Size int
+
+		// Affiliation is a comma-separated list of
+		// affiliations, such as AffiliationOwner, used to filter the
+		// repositories returned.
+		Affiliation string
+	}
+
+	// Collaborator represents a repository collaborator and
+	// the permission level granted to the user.
+	Collaborator struct {
+		User
+		Permission string // admin, write or read
+	}
+
+	// Contributor represents a repository contributor and
+	// the number of commits authored by the user.
+	Contributor struct {
+		User
+		Commits int
+	}
+
+	// Invitation represents a pending invitation for a user
+	// to collaborate on a repository.
+	Invitation struct {
+		ID         int
+		Repo       Repository
+		Invitee    User
+		Inviter    User
+		Permission string
+		Link       string
+		Created    time.Time
+	}
+
 	// Hook represents a repository hook.
 	Hook struct {
-		ID         string
-		Name       string
-		Target     string
-		Events     []string
-		Active     bool
-		SkipVerify bool
+		ID          string
+		Name        string
+		Target      string
+		Events      []string
+		Active      bool
+		SkipVerify  bool
+		ContentType string
+
+		// Parsed is the structured view of the native
+		// Events. Native events without an equivalent
+		// HookEvents field are ignored.
+		Parsed HookEvents
 	}
 
 	// HookInput provides the input fields required for
@@ -52,6 +150,11 @@ type (
 		Events     HookEvents
 		SkipVerify bool
 
+		// ContentType is the payload format of the hook
+		// deliveries, either json or form. The provider
+		// default is used when empty.
+		ContentType string
+
 		// NativeEvents are used to create hooks with
 		// provider-specific event types that cannot be
 		// abstracted or represented in HookEvents.
@@ -63,11 +166,42 @@ type (
 		Branch             bool
 		Issue              bool
 		IssueComment       bool
+		Job                bool
+		Member             bool
+		Pipeline           bool
 		PullRequest        bool
 		PullRequestComment bool
 		Push               bool
 		ReviewComment      bool
 		Tag                bool
+		Team               bool
+	}
+
+	// Pipeline represents a CI pipeline.
+	Pipeline struct {
+		ID     int
+		Status State
+		Ref    string
+		Sha    string
+		Link   string
+	}
+
+	// Secret represents a repository secret. The secret
+	// value cannot be read back once it is set.
+	Secret struct {
+		Name    string
+		Created time.Time
+		Updated time.Time
+	}
+
+	// Attachment represents a file uploaded to a repository,
+	// which can be embedded in comments using the Markdown
+	// reference. The URL may be relative to the repository
+	// web address.
+	Attachment struct {
+		Name     string
+		URL      string
+		Markdown string
 	}
 
 	// CombinedStatus is the latest statuses for a ref.
@@ -83,6 +217,11 @@ type (
 		Label  string
 		Desc   string
 		Target string
+
+		// Created and Updated are the status timestamps
+		// assigned by the provider, if reported.
+		Created time.Time
+		Updated time.Time
 	}
 
 	// StatusInput provides the input fields required for
@@ -99,6 +238,15 @@ type (
 		// Find returns a repository by name.
 		Find(context.Context, string) (*Repository, *Response, error)
 
+		// FindWithViewerState returns a repository by name,
+		// including whether the authenticated user stars and
+		// watches it. This requires additional requests.
+		FindWithViewerState(ctx context.Context, repo string) (*Repository, *Response, error)
+
+		// Exists returns true if the repository exists and is
+		// visible to the authenticated user.
+		Exists(context.Context, string) (bool, *Response, error)
+
 		// FindHook returns a repository hook.
 		FindHook(context.Context, string, string) (*Hook, *Response, error)
 
@@ -108,16 +256,22 @@ type (
 		// List returns a list of repositories.
 		List(context.Context, ListOptions) ([]*Repository, *Response, error)
 
+		// ListWithOptions returns a list of repositories
+		// filtered by the given options.
+		ListWithOptions(ctx context.Context, opts RepositoryListOptions) ([]*Repository, *Response, error)
+
 		// ListLabels returns the labels on a repo
 		ListLabels(context.Context, string, ListOptions) ([]*Label, *Response, error)
 
 		// ListHooks returns a list or repository hooks.
 		ListHooks(context.Context, string, ListOptions) ([]*Hook, *Response, error)
 
-		// ListStatus returns a list of commit statuses.
+		// ListStatus returns a list of commit statuses, sorted
+		// by label and then by updated time, most recent first.
 		ListStatus(context.Context, string, string, ListOptions) ([]*Status, *Response, error)
 
-		// FindCombinedStatus returns the combined status for a ref
+		// FindCombinedStatus returns the combined status for a ref.
+		// The statuses are sorted in the same order as ListStatus.
 		FindCombinedStatus(ctx context.Context, repo, ref string) (*CombinedStatus, *Response, error)
 
 		// CreateHook creates a new repository webhook.
@@ -126,6 +280,15 @@ type (
 		// CreateStatus creates a new commit status.
 		CreateStatus(context.Context, string, string, *StatusInput) (*Status, *Response, error)
 
+		// CreateStatuses creates the commit statuses concurrently,
+		// returning them in input order. Failures are aggregated
+		// into the returned error and no response is returned.
+		CreateStatuses(ctx context.Context, repo, ref string, inputs []*StatusInput) ([]*Status, *Response, error)
+
+		// DeleteStatus deletes the commit status with the given key,
+		// which is the status label used when creating it.
+		DeleteStatus(ctx context.Context, repo, ref, key string) (*Response, error)
+
 		// DeleteHook deletes a repository webhook.
 		DeleteHook(context.Context, string, string) (*Response, error)
 
@@ -133,10 +296,83 @@ type (
 		IsCollaborator(ctx context.Context, repo, user string) (bool, *Response, error)
 
 		// ListCollaborators lists the collaborators on a repository
-		ListCollaborators(ctx context.Context, repo string) ([]User, *Response, error)
+		ListCollaborators(ctx context.Context, repo string, opts ListOptions) ([]User, *Response, error)
+
+		// ListCollaboratorsWithPermission lists the collaborators on a
+		// repository with their permission level
+		ListCollaboratorsWithPermission(ctx context.Context, repo string, opts ListOptions) ([]*Collaborator, *Response, error)
+
+		// ListContributors lists the contributors to the
+		// repository, with their commit counts.
+		ListContributors(ctx context.Context, repo string, opts ListOptions) ([]*Contributor, *Response, error)
 
 		// FindUserPermission returns the user's permission level for a repo
 		FindUserPermission(ctx context.Context, repo string, user string) (string, *Response, error)
+
+		// AddCollaborator adds a collaborator to the repository with the given
+		// permission level. It returns true if an invitation was created instead
+		// of the user being added directly.
+		AddCollaborator(ctx context.Context, repo, user, permission string) (bool, *Response, error)
+
+		// RemoveCollaborator removes a collaborator from the repository
+		RemoveCollaborator(ctx context.Context, repo, user string) (*Response, error)
+
+		// ListInvitations lists the pending collaborator invitations for a repository
+		ListInvitations(ctx context.Context, repo string, opts ListOptions) ([]*Invitation, *Response, error)
+
+		// DeleteInvitation deletes a pending collaborator invitation
+		DeleteInvitation(ctx context.Context, repo string, id int) (*Response, error)
+
+		// DownloadArchive downloads an archive of the repository
+		// at the given ref. The format is ArchiveFormatTarGz or
+		// ArchiveFormatZip. The archive is streamed and the caller
+		// must close the returned reader.
+		DownloadArchive(ctx context.Context, repo, ref, format string) (io.ReadCloser, *Response, error)
+
+		// UploadAttachment uploads a file to the repository, so
+		// that it can be referenced from issue and pull request
+		// comments.
+		UploadAttachment(ctx context.Context, repo, name string, r io.Reader) (*Attachment, *Response, error)
+
+		// Create creates a new repository. The repository is
+		// created in the Namespace organization when set, and
+		// for the authenticated user otherwise.
+		Create(ctx context.Context, input *RepositoryInput) (*Repository, *Response, error)
+
+		// CreateFromTemplate creates a new repository from a template repository.
+		CreateFromTemplate(ctx context.Context, templateRepo string, input *RepositoryInput) (*Repository, *Response, error)
+
+		// TriggerPipeline creates a new CI pipeline for the ref,
+		// with the given pipeline variables.
+		TriggerPipeline(ctx context.Context, repo, ref string, vars map[string]string) (*Pipeline, *Response, error)
+
+		// DispatchWorkflow triggers a workflow run for the ref,
+		// where the workflow is identified by its file name
+		// or id.
+		DispatchWorkflow(ctx context.Context, repo, workflow, ref string, inputs map[string]interface{}) (*Response, error)
+
+		// Dispatch sends a repository dispatch event with the
+		// given event type and client payload.
+		Dispatch(ctx context.Context, repo, eventType string, payload map[string]interface{}) (*Response, error)
+
+		// ListSecrets returns the names of the repository secrets.
+		ListSecrets(ctx context.Context, repo string, opts ListOptions) ([]*Secret, *Response, error)
+
+		// SetSecret creates or updates a repository secret.
+		SetSecret(ctx context.Context, repo, name, value string) (*Response, error)
+
+		// DeleteSecret deletes a repository secret.
+		DeleteSecret(ctx context.Context, repo, name string) (*Response, error)
+
+		// Star stars the repository for the authenticated user.
+		Star(ctx context.Context, repo string) (*Response, error)
+
+		// Unstar removes the star from the repository for the
+		// authenticated user.
+		Unstar(ctx context.Context, repo string) (*Response, error)
+
+		// SetArchived archives or unarchives the repository.
+		SetArchived(ctx context.Context, repo string, archived bool) (*Response, error)
 	}
 )
 
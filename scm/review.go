@@ -9,6 +9,12 @@ import (
 	"time"
 )
 
+// Review comment diff sides.
+const (
+	ReviewSideLeft  = "LEFT"
+	ReviewSideRight = "RIGHT"
+)
+
 type (
 	// Review represents a review comment.
 	Review struct {
@@ -22,6 +28,27 @@ type (
 		Author  User
 		Created time.Time
 		Updated time.Time
+
+		// ThreadID identifies the discussion thread the
+		// comment belongs to, when reported by the provider.
+		ThreadID string
+
+		// Resolved is true when the comment thread has been
+		// resolved, when reported by the provider.
+		Resolved bool
+	}
+
+	// ReviewThread represents a thread of review comments
+	// attached to a file line.
+	ReviewThread struct {
+		ID       string
+		Path     string
+		Line     int
+		Resolved bool
+
+		// Comments is the list of thread comments, in the
+		// order they were created.
+		Comments []*Review
 	}
 
 	// ReviewHook represents a review web hook
@@ -44,6 +71,23 @@ type (
 		Line int
 	}
 
+	// ReviewCommentInput provides the input fields required
+	// for creating an inline review comment on a file line.
+	ReviewCommentInput struct {
+		Body string
+		Path string
+		Line int
+
+		// Side is the side of the diff the line belongs to,
+		// either ReviewSideLeft or ReviewSideRight. Defaults
+		// to ReviewSideRight.
+		Side string
+
+		// Sha is the commit the comment applies to. When
+		// empty the pull request head commit is used.
+		Sha string
+	}
+
 	// ReviewService provides access to review resources.
 	ReviewService interface {
 		// Find returns the review comment by id.
@@ -52,11 +96,39 @@ type (
 		// List returns the review comment list.
 		List(context.Context, string, int, ListOptions) ([]*Review, *Response, error)
 
+		// ListComments returns the inline review comments of
+		// the pull request. Conversation comments are listed by
+		// the issue service instead.
+		ListComments(ctx context.Context, repo string, number int, opts ListOptions) ([]*Review, *Response, error)
+
 		// Create creates a review comment.
 		Create(context.Context, string, int, *ReviewInput) (*Review, *Response, error)
 
 		// Delete deletes a review comment.
 		Delete(context.Context, string, int, int) (*Response, error)
+
+		// CreateComment creates an inline review comment on
+		// a file line, outside of a full review.
+		CreateComment(ctx context.Context, repo string, number int, input *ReviewCommentInput) (*Review, *Response, error)
+
+		// EditComment updates the body of a review comment.
+		EditComment(ctx context.Context, repo string, number, id int, body string) (*Review, *Response, error)
+
+		// DeleteComment deletes a review comment.
+		DeleteComment(ctx context.Context, repo string, number, id int) (*Response, error)
+
+		// ListThreads returns the review comment threads of
+		// the pull request.
+		ListThreads(ctx context.Context, repo string, number int) ([]*ReviewThread, *Response, error)
+
+		// ResolveThread marks a review comment thread as resolved.
+		ResolveThread(ctx context.Context, repo string, number int, threadID string) (*Response, error)
+
+		// UnresolveThread marks a review comment thread as unresolved.
+		UnresolveThread(ctx context.Context, repo string, number int, threadID string) (*Response, error)
+
+		// CreateCommentReply creates a reply to an existing review comment thread.
+		CreateCommentReply(ctx context.Context, repo string, number, inReplyTo int, body string) (*Comment, *Response, error)
 	}
 )
 
@@ -0,0 +1,86 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// Ruleset represents a repository ruleset, which
+	// supersedes branch protection on GitHub.
+	Ruleset struct {
+		ID          int
+		Name        string
+		Target      string // branch or tag
+		Enforcement string // active, evaluate or disabled
+
+		// Include and Exclude are the ref name patterns the
+		// ruleset applies to, for example refs/heads/main or
+		// ~DEFAULT_BRANCH.
+		Include []string
+		Exclude []string
+
+		Rules RulesetRules
+	}
+
+	// RulesetRules provides the rules enforced by a ruleset.
+	RulesetRules struct {
+		Deletion              bool
+		NonFastForward        bool
+		RequiredLinearHistory bool
+
+		// RequiredStatusChecks is the list of status check
+		// contexts that must pass before a ref is updated.
+		RequiredStatusChecks []string
+
+		// StrictStatusChecks requires the branch to be up to
+		// date with the target before merging.
+		StrictStatusChecks bool
+
+		// PullRequest requires changes to be made through a
+		// pull request, when not nil.
+		PullRequest *RulesetPullRequest
+	}
+
+	// RulesetPullRequest provides the pull request review
+	// requirements of a ruleset.
+	RulesetPullRequest struct {
+		RequiredApprovals       int
+		DismissStaleReviews     bool
+		RequireCodeOwnerReview  bool
+		RequireLastPushApproval bool
+		RequireThreadResolution bool
+	}
+
+	// RulesetInput provides the input fields required for
+	// creating or updating a ruleset.
+	RulesetInput struct {
+		Name        string
+		Target      string
+		Enforcement string
+		Include     []string
+		Exclude     []string
+		Rules       RulesetRules
+	}
+
+	// RulesetService provides access to repository rulesets.
+	RulesetService interface {
+		// Find returns the repository ruleset by id.
+		Find(ctx context.Context, repo string, id int) (*Ruleset, *Response, error)
+
+		// List returns the repository rulesets. The rules
+		// may not be populated when listing rulesets.
+		List(ctx context.Context, repo string, opts ListOptions) ([]*Ruleset, *Response, error)
+
+		// Create creates a new repository ruleset.
+		Create(ctx context.Context, repo string, input *RulesetInput) (*Ruleset, *Response, error)
+
+		// Update updates the repository ruleset. The rules of
+		// the ruleset are replaced by the input rules.
+		Update(ctx context.Context, repo string, id int, input *RulesetInput) (*Ruleset, *Response, error)
+
+		// Delete deletes the repository ruleset.
+		Delete(ctx context.Context, repo string, id int) (*Response, error)
+	}
+)
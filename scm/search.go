@@ -0,0 +1,27 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// RepositorySearchResult represents a page of repository
+	// search results.
+	RepositorySearchResult struct {
+		// Total is the total number of repositories matching
+		// the query, across all pages.
+		Total        int
+		Repositories []*Repository
+	}
+
+	// SearchService provides access to search resources.
+	SearchService interface {
+		// Repositories returns the repositories matching the
+		// query. The query uses the GitHub search syntax, and
+		// the topic:, org: and user: qualifiers are supported
+		// by all drivers that implement search.
+		Repositories(ctx context.Context, query string, opts ListOptions) (*RepositorySearchResult, *Response, error)
+	}
+)
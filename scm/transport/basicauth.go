@@ -8,7 +8,11 @@ import "net/http"
 
 // BasicAuth is an http.RoundTripper that makes HTTP
 // requests, wrapping a base RoundTripper and adding a
-// Basic Authorization header.
+// Basic Authorization header. It is typically used with
+// Bitbucket Server, which accepts a username with a
+// password or personal access token. An existing
+// Authorization header, such as one set by a token
+// transport, is never overwritten.
 type BasicAuth struct {
 	Base http.RoundTripper
 
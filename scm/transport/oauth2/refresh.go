@@ -20,6 +20,13 @@ import (
 // expirations due to client-server time mismatches.
 const expiryDelta = time.Minute
 
+// clock provides a interface for current time providers. A clock
+// can be used in place of calling time.Now() directly, so that the
+// token expiry can be tested deterministically.
+type clock interface {
+	Now() time.Time
+}
+
 // Refresher is an http.RoundTripper that refreshes oauth
 // tokens, wrapping a base RoundTripper and refreshing the
 // token if expired.
@@ -33,6 +40,10 @@ type Refresher struct {
 
 	Source scm.TokenSource
 	Client *http.Client
+
+	// clock is the current time provider, which defaults
+	// to time.Now when nil.
+	clock clock
 }
 
 // Token returns a token. If the token is missing or
@@ -42,7 +53,7 @@ func (t *Refresher) Token(ctx context.Context) (*scm.Token, error) {
 	if err != nil {
 		return nil, err
 	}
-	if !expired(token) {
+	if !expired(token, t.now()) {
 		return token, nil
 	}
 	err = t.Refresh(token)
@@ -91,7 +102,7 @@ func (t *Refresher) Refresh(token *scm.Token) error {
 
 	token.Token = out.Access
 	token.Refresh = out.Refresh
-	token.Expires = time.Now().Add(
+	token.Expires = t.now().Add(
 		time.Duration(out.Expires) * time.Second,
 	)
 	return nil
@@ -106,8 +117,16 @@ func (t *Refresher) client() *http.Client {
 	return http.DefaultClient
 }
 
-// expired reports whether the token is expired.
-func expired(token *scm.Token) bool {
+// now returns the current time of the configured clock.
+func (t *Refresher) now() time.Time {
+	if t.clock != nil {
+		return t.clock.Now()
+	}
+	return time.Now()
+}
+
+// expired reports whether the token is expired at the given time.
+func expired(token *scm.Token, now time.Time) bool {
 	if len(token.Refresh) == 0 {
 		return false
 	}
@@ -115,7 +134,7 @@ func expired(token *scm.Token) bool {
 		return false
 	}
 	return token.Expires.Add(-expiryDelta).
-		Before(time.Now())
+		Before(now)
 }
 
 // tokenGrant is the token returned by the token endpoint.
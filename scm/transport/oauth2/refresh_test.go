@@ -192,8 +192,83 @@ func TestExpired(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got, want := expired(test.token), test.expired; got != want {
+		if got, want := expired(test.token, time.Now()), test.expired; got != want {
 			t.Errorf("Want token expired %v, got %v at index %d", want, got, i)
 		}
 	}
 }
+
+func TestRefresh_Expiry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://bitbucket.org").
+		Post("/site/oauth2/access_token").
+		Reply(200).
+		BodyString(`
+			{
+				"access_token": "9698fa6a8113b3",
+				"expires_in": 7200,
+				"refresh_token": "3a2bfce4cb9b0f",
+				"token_type": "bearer"
+			}
+		`)
+
+	clock := &fakeClock{now: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)}
+	before := &scm.Token{
+		Token:   "6084984dab20e6",
+		Refresh: "3a2bfce4cb9b0f",
+		Expires: clock.now.Add(time.Hour),
+	}
+	r := Refresher{
+		ClientID:     "dafe3804960dab",
+		ClientSecret: "20e651849b1f12",
+		Endpoint:     "https://bitbucket.org/site/oauth2/access_token",
+		Source:       StaticTokenSource(before),
+		clock:        clock,
+	}
+
+	ctx := context.Background()
+	after, err := r.Token(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if after.Token != "6084984dab20e6" {
+		t.Errorf("Expect token not refreshed before expiry")
+	}
+	if !gock.IsPending() {
+		t.Errorf("Expect no refresh request before expiry")
+	}
+
+	// advance the clock into the expiry window.
+	clock.Advance(time.Hour - time.Second)
+
+	after, err = r.Token(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if after.Token != "9698fa6a8113b3" {
+		t.Errorf("Expect token refreshed after expiry")
+	}
+	if got, want := after.Expires, clock.now.Add(2*time.Hour); !got.Equal(want) {
+		t.Errorf("Want token expiry %s, got %s", want, got)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+// fakeClock is a clock whose current time only changes
+// when it is advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
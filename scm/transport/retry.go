@@ -0,0 +1,146 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// defaultMaxRetries is the maximum number of retries when the
+// Retry transport Max is not set.
+const defaultMaxRetries = 3
+
+// Retry is an http.RoundTripper that makes HTTP requests,
+// wrapping a base RoundTripper and retrying the idempotent
+// requests that fail with a 502, 503 or 504 status. Requests
+// with a body are only retried when the body can be replayed.
+// A 503 with a Retry-After header is retried after the delay
+// the server asks for. The retries are reported in the
+// scm.Response Retries field.
+type Retry struct {
+	Base http.RoundTripper
+
+	// Max is the maximum number of retries, which
+	// defaults to 3.
+	Max int
+
+	// Backoff is the delay before the first retry, which
+	// is doubled for every further retry.
+	Backoff time.Duration
+}
+
+// RoundTrip sends the request, retrying it while the
+// response status is temporary.
+func (t *Retry) RoundTrip(r *http.Request) (*http.Response, error) {
+	res, err := t.base().RoundTrip(r)
+	if !idempotent(r.Method) {
+		return res, err
+	}
+	delay := t.Backoff
+	for i := 0; i < t.max(); i++ {
+		if err != nil || !temporary(res.StatusCode) {
+			break
+		}
+		if r.Body != nil && r.GetBody == nil {
+			break
+		}
+		wait := delay
+		if after, ok := retryAfter(res); ok {
+			wait = after
+		}
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-r.Context().Done():
+				timer.Stop()
+				return res, nil
+			case <-timer.C:
+			}
+		}
+		delay *= 2
+		r2 := r
+		if r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return res, nil
+			}
+			r2 = cloneRequest(r)
+			r2.Body = body
+		}
+		res.Body.Close()
+		scm.CountRetry(r.Context())
+		res, err = t.base().RoundTrip(r2)
+	}
+	return res, err
+}
+
+// max returns the maximum number of retries.
+func (t *Retry) max() int {
+	if t.Max > 0 {
+		return t.Max
+	}
+	return defaultMaxRetries
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *Retry) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// temporary reports whether the status code indicates a
+// temporary server failure.
+func temporary(status int) bool {
+	switch status {
+	case http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the delay of the Retry-After header of a
+// 503 response, given either in seconds or as an http date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// idempotent reports whether requests with the method can be
+// safely sent more than once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet,
+		http.MethodHead,
+		http.MethodPut,
+		http.MethodDelete,
+		http.MethodOptions:
+		return true
+	}
+	return false
+}
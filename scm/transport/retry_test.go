@@ -0,0 +1,236 @@
+// Copyright 2018 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestRetry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Times(2).
+		Reply(503)
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200)
+
+	client := newRetryClient(&Retry{})
+	res, err := client.Do(context.Background(), &scm.Request{
+		Method: "GET",
+		Path:   "user",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := res.Retries, 2; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+	if gock.IsPending() {
+		t.Errorf("pending API requests")
+	}
+}
+
+func TestRetry_Max(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Times(3).
+		Reply(503)
+
+	client := newRetryClient(&Retry{Max: 2})
+	res, err := client.Do(context.Background(), &scm.Request{
+		Method: "GET",
+		Path:   "user",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.Status, 503; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := res.Retries, 2; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+}
+
+func TestRetry_Body(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/topics").
+		BodyString(`{"names":["go"]}`).
+		Reply(502)
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/topics").
+		BodyString(`{"names":["go"]}`).
+		Reply(200)
+
+	client := newRetryClient(&Retry{})
+	res, err := client.Do(context.Background(), &scm.Request{
+		Method: "PUT",
+		Path:   "repos/octocat/hello-world/topics",
+		Body:   strings.NewReader(`{"names":["go"]}`),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := res.Retries, 1; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+}
+
+func TestRetry_NotIdempotent(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/user/repos").
+		BodyString(`{"name":"hello-world"}`).
+		Reply(502)
+
+	client := newRetryClient(&Retry{})
+	res, err := client.Do(context.Background(), &scm.Request{
+		Method: "POST",
+		Path:   "user/repos",
+		Body:   strings.NewReader(`{"name":"hello-world"}`),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.Status, 502; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := res.Retries, 0; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+}
+
+func TestRetry_NotTemporary(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(500)
+
+	client := newRetryClient(&Retry{})
+	res, err := client.Do(context.Background(), &scm.Request{
+		Method: "GET",
+		Path:   "user",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.Retries, 0; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(503).
+		SetHeader("Retry-After", "60")
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200)
+
+	// the context expires long before the requested delay, so
+	// the request must not be retried.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := newRetryClient(&Retry{})
+	res, err := client.Do(ctx, &scm.Request{
+		Method: "GET",
+		Path:   "user",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+
+	if got, want := res.Status, 503; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := res.Retries, 0; got != want {
+		t.Errorf("Want %d retries, got %d", want, got)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		status int
+		header string
+		wait   time.Duration
+		ok     bool
+	}{
+		{status: 503, header: "", ok: false},
+		{status: 503, header: "120", wait: 2 * time.Minute, ok: true},
+		{status: 503, header: "Mon, 02 Jan 2006 15:04:05 GMT", wait: 0, ok: true},
+		{status: 503, header: "soon", ok: false},
+		{status: 502, header: "120", ok: false},
+	}
+	for _, test := range tests {
+		res := &http.Response{StatusCode: test.status, Header: http.Header{}}
+		if test.header != "" {
+			res.Header.Set("Retry-After", test.header)
+		}
+		wait, ok := retryAfter(res)
+		if wait != test.wait || ok != test.ok {
+			t.Errorf("Want Retry-After %q to wait %v (%v), got %v (%v)", test.header, test.wait, test.ok, wait, ok)
+		}
+	}
+
+	res := &http.Response{StatusCode: 503, Header: http.Header{}}
+	res.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if wait, ok := retryAfter(res); !ok || wait <= 59*time.Minute || wait > time.Hour {
+		t.Errorf("Want a Retry-After date to wait about an hour, got %v", wait)
+	}
+}
+
+func newRetryClient(transport http.RoundTripper) *scm.Client {
+	client := &scm.Client{
+		Client: &http.Client{Transport: transport},
+	}
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	return client
+}
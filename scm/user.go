@@ -31,5 +31,12 @@ type (
 
 		// FindLogin returns the user account by username.
 		FindLogin(context.Context, string) (*User, *Response, error)
+
+		// AcceptInvitation accepts a repository invitation for the authenticated user.
+		AcceptInvitation(context.Context, int) (*Response, error)
+
+		// ListStarred returns the repositories starred by the
+		// authenticated user.
+		ListStarred(context.Context, ListOptions) ([]*Repository, *Response, error)
 	}
 )
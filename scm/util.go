@@ -5,6 +5,8 @@
 package scm
 
 import (
+	"context"
+	"sort"
 	"strings"
 )
 
@@ -21,6 +23,18 @@ func Split(s string) (owner, name string) {
 	return
 }
 
+// SplitFull splits the full repository name into the
+// namespace and the name. Unlike Split, the namespace is
+// everything before the last slash, which preserves nested
+// namespaces such as GitLab subgroups.
+func SplitFull(s string) (namespace, name string) {
+	i := strings.LastIndex(s, "/")
+	if i == -1 {
+		return "", s
+	}
+	return s[:i], s[i+1:]
+}
+
 // Join joins the repository owner and name segments to
 // create a fully qualified repository name.
 func Join(owner, name string) string {
@@ -89,3 +103,52 @@ func ConvertStatusInputToStatus(input *StatusInput) *Status {
 		Target: input.Target,
 	}
 }
+
+// SortStatuses sorts the statuses by label, and statuses with
+// the same label by updated time, most recent first. The drivers
+// sort the returned statuses so that the order does not depend
+// on the provider.
+func SortStatuses(statuses []*Status) {
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].Label != statuses[j].Label {
+			return statuses[i].Label < statuses[j].Label
+		}
+		return statuses[i].Updated.After(statuses[j].Updated)
+	})
+}
+
+// SortComments sorts the comments by created time, oldest first.
+func SortComments(comments []*Comment) {
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].Created.Before(comments[j].Created)
+	})
+}
+
+// ListFunc lists a single page of results.
+type ListFunc[T any] func(ctx context.Context, opts ListOptions) ([]T, *Response, error)
+
+// ListAll calls list for every page, starting from the first page
+// when opts.Page is not set, until the Response Page.Next value is
+// zero. It returns the results of all pages with the response of
+// the last page, and stops with the context error if the context
+// is cancelled between pages.
+func ListAll[T any](ctx context.Context, opts ListOptions, list ListFunc[T]) ([]T, *Response, error) {
+	if opts.Page == 0 {
+		opts.Page = 1
+	}
+	all := []T{}
+	for {
+		out, res, err := list(ctx, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, out...)
+		if res.Page.Next == 0 {
+			return all, res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return all, res, err
+		}
+		opts.Page = res.Page.Next
+	}
+}
@@ -5,7 +5,9 @@
 package scm
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,6 +32,27 @@ func TestSplit(t *testing.T) {
 	}
 }
 
+func TestSplitFull(t *testing.T) {
+	tests := []struct {
+		value, namespace, name string
+	}{
+		{"octocat/hello-world", "octocat", "hello-world"},
+		{"gitlab-org/frontend/web-ide", "gitlab-org/frontend", "web-ide"},
+		{"group/subgroup/team/project", "group/subgroup/team", "project"},
+		{"hello-world", "", "hello-world"},
+		{value: ""}, // empty value returns nothing
+	}
+	for _, test := range tests {
+		namespace, name := SplitFull(test.value)
+		if got, want := namespace, test.namespace; got != want {
+			t.Errorf("Got repository namespace %s, want %s", got, want)
+		}
+		if got, want := name, test.name; got != want {
+			t.Errorf("Got repository name %s, want %s", got, want)
+		}
+	}
+}
+
 func TestJoin(t *testing.T) {
 	got, want := Join("octocat", "hello-world"), "octocat/hello-world"
 	if got != want {
@@ -132,3 +155,80 @@ func TestIsRef(t *testing.T) {
 		}
 	}
 }
+
+func TestSortStatuses(t *testing.T) {
+	older := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	statuses := []*Status{
+		{Label: "lint", Desc: "old", Updated: older},
+		{Label: "build", Desc: "only", Updated: older},
+		{Label: "lint", Desc: "new", Updated: newer},
+		{Label: "lint", Desc: "unknown"},
+	}
+	SortStatuses(statuses)
+
+	var got []string
+	for _, status := range statuses {
+		got = append(got, status.Label+"/"+status.Desc)
+	}
+	assert.Equal(t, []string{"build/only", "lint/new", "lint/old", "lint/unknown"}, got)
+}
+
+func TestSortComments(t *testing.T) {
+	older := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	comments := []*Comment{
+		{ID: 3, Created: newer},
+		{ID: 1, Created: older},
+		{ID: 2, Created: older},
+	}
+	SortComments(comments)
+
+	var got []int
+	for _, comment := range comments {
+		got = append(got, comment.ID)
+	}
+	assert.Equal(t, []int{1, 2, 3}, got)
+}
+
+func TestListAll(t *testing.T) {
+	pages := map[int][]string{
+		1: {"a", "b"},
+		2: {"c", "d"},
+		3: {"e"},
+	}
+	list := func(ctx context.Context, opts ListOptions) ([]string, *Response, error) {
+		res := &Response{}
+		if opts.Page < len(pages) {
+			res.Page.Next = opts.Page + 1
+		}
+		return pages[opts.Page], res, nil
+	}
+	got, _, err := ListAll(context.Background(), ListOptions{Size: 2}, list)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, got)
+}
+
+func TestListAll_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	list := func(ctx context.Context, opts ListOptions) ([]string, *Response, error) {
+		calls++
+		cancel()
+		res := &Response{}
+		res.Page.Next = opts.Page + 1
+		return []string{"a"}, res, nil
+	}
+	got, _, err := ListAll(ctx, ListOptions{}, list)
+	if err != context.Canceled {
+		t.Errorf("Expect context canceled error, got %v", err)
+	}
+	assert.Equal(t, []string{"a"}, got)
+	if calls != 1 {
+		t.Errorf("Expect the second page not to be requested, got %d calls", calls)
+	}
+}
@@ -7,12 +7,17 @@ package scm
 import (
 	"errors"
 	"net/http"
+	"time"
 )
 
 var (
 	// ErrSignatureInvalid is returned when the webhook
 	// signature is invalid or cannot be calculated.
 	ErrSignatureInvalid = errors.New("Invalid webhook signature")
+
+	// ErrWebhookExpired is returned when the webhook
+	// delivery is older than the client WebhookMaxAge.
+	ErrWebhookExpired = errors.New("Webhook delivery has expired")
 )
 
 type (
@@ -89,6 +94,19 @@ type (
 		Issue   Issue
 		Comment Comment
 		Sender  User
+		Changes CommentHookChanges
+	}
+
+	// CommentHookChanges describes the previous values of
+	// an edited comment, where reported by the provider.
+	CommentHookChanges struct {
+		Body CommentHookChangesFrom
+	}
+
+	// CommentHookChangesFrom holds the previous value of
+	// an edited field.
+	CommentHookChangesFrom struct {
+		From string
 	}
 
 	PullRequestHookBranchFrom struct {
@@ -125,6 +143,7 @@ type (
 		PullRequest PullRequest
 		Comment     Comment
 		Sender      User
+		Changes     CommentHookChanges
 	}
 
 	// ReviewCommentHook represents a pull request review
@@ -149,6 +168,77 @@ type (
 		Task      string
 	}
 
+	// RepositoryHook represents a repository event, eg a
+	// repository rename, move or mirror synchronization.
+	// Old is the repository before a rename or move, and is
+	// empty for other actions.
+	RepositoryHook struct {
+		Action Action
+		Repo   Repository
+		Old    Repository
+		Sender User
+	}
+
+	// PipelineHook represents a CI pipeline event. This is
+	// currently a GitLab-specific event type.
+	PipelineHook struct {
+		ID     int
+		Status State
+		Ref    Reference
+		Stages []string
+		Link   string
+		Repo   Repository
+		Sender User
+	}
+
+	// JobHook represents a CI job event. This is currently
+	// a GitLab-specific event type.
+	JobHook struct {
+		ID         int
+		Name       string
+		Stage      string
+		Status     State
+		Ref        Reference
+		PipelineID int
+		Repo       Repository
+		Sender     User
+	}
+
+	// PingHook represents a ping event, which GitHub sends
+	// when a new webhook is created.
+	PingHook struct {
+		HookID string
+		Zen    string
+		Repo   Repository
+		Sender User
+	}
+
+	// MemberHook represents a membership event, eg a user
+	// added to or removed from a repository or a team. Team
+	// is empty for repository collaborator events, and Repo
+	// is empty for team membership events.
+	MemberHook struct {
+		Action       Action
+		Member       User
+		Team         Team
+		Scope        string
+		Organization Organization
+		Repo         Repository
+		Sender       User
+	}
+
+	// TeamHook represents a team event, eg a team created,
+	// deleted or edited, or a team added to or removed
+	// from a repository. Repo is only populated when the
+	// team repository access changes.
+	TeamHook struct {
+		Action       Action
+		Team         Team
+		Organization Organization
+		Repo         Repository
+		Sender       User
+	}
+
 	// SecretFunc provides the Webhook parser with the
 	// secret key used to validate webhook authenticity.
 	SecretFunc func(webhook Webhook) (string, error)
@@ -168,9 +258,62 @@ type (
 func (h *PushHook) Repository() Repository               { return h.Repo }
 func (h *BranchHook) Repository() Repository             { return h.Repo }
 func (h *DeployHook) Repository() Repository             { return h.Repo }
+func (h *PingHook) Repository() Repository               { return h.Repo }
+func (h *MemberHook) Repository() Repository             { return h.Repo }
+func (h *TeamHook) Repository() Repository               { return h.Repo }
+func (h *PipelineHook) Repository() Repository           { return h.Repo }
+func (h *RepositoryHook) Repository() Repository         { return h.Repo }
+func (h *JobHook) Repository() Repository                { return h.Repo }
 func (h *TagHook) Repository() Repository                { return h.Repo }
 func (h *IssueHook) Repository() Repository              { return h.Repo }
 func (h *IssueCommentHook) Repository() Repository       { return h.Repo }
 func (h *PullRequestHook) Repository() Repository        { return h.Repo }
 func (h *PullRequestCommentHook) Repository() Repository { return h.Repo }
 func (h *ReviewCommentHook) Repository() Repository      { return h.Repo }
+
+// CheckWebhookAge returns ErrWebhookExpired if the webhook was
+// delivered more than maxAge ago. The check is skipped when
+// maxAge is zero or the delivery time is unknown.
+func CheckWebhookAge(delivered time.Time, maxAge time.Duration) error {
+	if maxAge <= 0 || delivered.IsZero() {
+		return nil
+	}
+	if time.Since(delivered) > maxAge {
+		return ErrWebhookExpired
+	}
+	return nil
+}
+
+// WebhookToEvents returns the hook events subscription that
+// produces the webhook. Webhooks without a matching subscription,
+// such as ping, deploy and repository hooks, return an empty set.
+func WebhookToEvents(w Webhook) HookEvents {
+	var events HookEvents
+	switch w.(type) {
+	case *PushHook:
+		events.Push = true
+	case *BranchHook:
+		events.Branch = true
+	case *TagHook:
+		events.Tag = true
+	case *IssueHook:
+		events.Issue = true
+	case *IssueCommentHook:
+		events.IssueComment = true
+	case *PullRequestHook:
+		events.PullRequest = true
+	case *PullRequestCommentHook:
+		events.PullRequestComment = true
+	case *ReviewCommentHook:
+		events.ReviewComment = true
+	case *PipelineHook:
+		events.Pipeline = true
+	case *JobHook:
+		events.Job = true
+	case *MemberHook:
+		events.Member = true
+	case *TeamHook:
+		events.Team = true
+	}
+	return events
+}
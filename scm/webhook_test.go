@@ -0,0 +1,35 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "testing"
+
+func TestWebhookToEvents(t *testing.T) {
+	tests := []struct {
+		webhook Webhook
+		want    HookEvents
+	}{
+		{&PushHook{}, HookEvents{Push: true}},
+		{&BranchHook{}, HookEvents{Branch: true}},
+		{&TagHook{}, HookEvents{Tag: true}},
+		{&IssueHook{}, HookEvents{Issue: true}},
+		{&IssueCommentHook{}, HookEvents{IssueComment: true}},
+		{&PullRequestHook{}, HookEvents{PullRequest: true}},
+		{&PullRequestCommentHook{}, HookEvents{PullRequestComment: true}},
+		{&ReviewCommentHook{}, HookEvents{ReviewComment: true}},
+		{&PipelineHook{}, HookEvents{Pipeline: true}},
+		{&JobHook{}, HookEvents{Job: true}},
+		{&MemberHook{}, HookEvents{Member: true}},
+		{&TeamHook{}, HookEvents{Team: true}},
+		{&DeployHook{}, HookEvents{}},
+		{&PingHook{}, HookEvents{}},
+		{&RepositoryHook{}, HookEvents{}},
+	}
+	for _, test := range tests {
+		if got := WebhookToEvents(test.webhook); got != test.want {
+			t.Errorf("Want events %+v for %T, got %+v", test.want, test.webhook, got)
+		}
+	}
+}